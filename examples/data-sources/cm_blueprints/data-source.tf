@@ -0,0 +1,3 @@
+data "cm_blueprints" "blueprints" {
+  name_regex = "^(dev|prod)-"
+}
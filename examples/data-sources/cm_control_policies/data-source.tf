@@ -0,0 +1,3 @@
+data "cm_control_policies" "control_policies" {
+  name_regex = "(?i)aws"
+}
@@ -0,0 +1,3 @@
+data "cm_control_policy_groups" "control_policy_groups" {
+  name_prefix = "Security"
+}
@@ -0,0 +1,3 @@
+data "cm_custom_roles" "custom_roles" {
+  name_prefix = "Deployer"
+}
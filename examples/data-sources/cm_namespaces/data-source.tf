@@ -0,0 +1,17 @@
+data "cm_namespaces" "all" {}
+
+data "cm_control_policy_group" "security" {
+  name = "Security"
+}
+
+resource "cm_control_policy_group_mappings" "security" {
+  control_policy_group_id = data.cm_control_policy_group.security.id
+
+  targets = [
+    for namespace in data.cm_namespaces.all.namespaces : {
+      target_id         = namespace.id
+      target_type       = "namespace"
+      enforcement_level = "bySeverity"
+    }
+  ]
+}
@@ -0,0 +1,3 @@
+data "cm_notification_endpoints" "notification_endpoints" {
+  name_regex = "-alerts$"
+}
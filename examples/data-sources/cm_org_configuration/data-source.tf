@@ -0,0 +1,9 @@
+data "cm_org_configuration" "org" {}
+
+resource "cm_namespace" "namespace" {
+  name = "Dev"
+
+  iac_config = {
+    terraform_version = data.cm_org_configuration.org.iac_config.terraform_version
+  }
+}
@@ -0,0 +1,14 @@
+data "cm_stack_discovery_preview" "preview" {
+  path_patterns         = ["environments/*/terraform/**", "modules/*/**"]
+  exclude_path_patterns = ["**/test/**"]
+  paths                 = fileset("${path.module}/../infrastructure", "**")
+}
+
+data "cm_stack_discovery_preview" "checkout" {
+  path_patterns = ["**/infrastructure/**"]
+  directory     = "${path.module}/../microservices"
+}
+
+output "discovered_stacks" {
+  value = { for s in data.cm_stack_discovery_preview.preview.stacks : s.name => s.matched_pattern }
+}
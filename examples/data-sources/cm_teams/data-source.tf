@@ -0,0 +1,3 @@
+data "cm_teams" "teams" {
+  name_prefix = "Platform"
+}
@@ -0,0 +1,3 @@
+data "cm_templates" "templates" {
+  name_prefix = "Prod"
+}
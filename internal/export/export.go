@@ -0,0 +1,599 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
+	sdkBlueprint "github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkControlPolicy "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
+	sdkControlPolicyGroup "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy_group"
+	sdkNamespace "github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	sdkNotification "github.com/control-monkey/controlmonkey-sdk-go/services/notification"
+	sdkStack "github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+	sdkTeam "github.com/control-monkey/controlmonkey-sdk-go/services/team"
+	sdkTemplate "github.com/control-monkey/controlmonkey-sdk-go/services/template"
+	sdkVariable "github.com/control-monkey/controlmonkey-sdk-go/services/variable"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	tfBlueprint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint"
+	blueprintNamespaces "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint_namespace_mappings"
+	tfControlPolicy "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy"
+	tfControlPolicyGroup "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_group"
+	controlPolicyGroupMapping "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_group_mappings"
+	controlPolicyMapping "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_mappings"
+	tfEventsSubscriptions "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/events_subscriptions"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/namespace"
+	tfNotificationEndpoint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/notification_endpoint"
+	tfSlackApp "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/notification_slack_app"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/team"
+	teamUsers "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/team_users"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/template"
+	templateNamespaces "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/template_namespace_mappings"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/variable"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	providerTypeName = "cm"
+	validationError  = "Validation Error"
+)
+
+// Services are the services of the SDK an organization is exported with.
+type Services struct {
+	Blueprint          sdkBlueprint.Service
+	ControlPolicy      sdkControlPolicy.Service
+	ControlPolicyGroup sdkControlPolicyGroup.Service
+	Namespace          sdkNamespace.Service
+	Notification       sdkNotification.Service
+	Stack              sdkStack.Service
+	Team               sdkTeam.Service
+	Template           sdkTemplate.Service
+	Variable           sdkVariable.Service
+}
+
+func NewServices(sess *session.Session) *Services {
+	return &Services{
+		Blueprint:          sdkBlueprint.New(sess),
+		ControlPolicy:      sdkControlPolicy.New(sess),
+		ControlPolicyGroup: sdkControlPolicyGroup.New(sess),
+		Namespace:          sdkNamespace.New(sess),
+		Notification:       sdkNotification.New(sess),
+		Stack:              sdkStack.New(sess),
+		Team:               sdkTeam.New(sess),
+		Template:           sdkTemplate.New(sess),
+		Variable:           sdkVariable.New(sess),
+	}
+}
+
+// Configuration reads the namespaces, stacks, variables, templates, blueprints, control policies, teams and
+// notifications of the organization and renders them as Terraform configuration of the provider. The result maps a
+// file name to its content: a file per resource type, imports.tf with an import block for every resource, provider.tf
+// and variables.tf with an input variable for every secret, because the API never returns them.
+//
+// The resources are filled in by the same state updates their Read uses, so applying the configuration after the
+// import plans no changes. Entities deleted while the export runs are left out.
+func Configuration(ctx context.Context, services *Services) (map[string][]byte, diag.Diagnostics) {
+	e := &exporter{
+		services:   services,
+		imports:    true,
+		names:      make(map[string]map[string]bool),
+		references: make(map[string]string),
+	}
+
+	diags := e.collect(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	retVal := e.render()
+
+	return retVal, diags
+}
+
+type exporter struct {
+	services  *Services
+	resources []*exportedResource
+	// imports adds an import block for every resource.
+	imports bool
+	// names holds the names used so far by resource type.
+	names map[string]map[string]bool
+	// references maps the id of an exported entity to the address of its resource.
+	references map[string]string
+}
+
+type exportedResource struct {
+	typeName string
+	name     string
+	// id is the id of the entity, other resources refer to it and it is imported by it.
+	id     string
+	schema schema.Schema
+	value  tftypes.Value
+	// inputVariables maps an attribute whose value is not returned by the API to the input variable that sets it.
+	inputVariables map[string]string
+}
+
+func (r *exportedResource) address() string {
+	return fmt.Sprintf("%s.%s", r.typeName, r.name)
+}
+
+// collection is how the entities of a type are listed, read and exported.
+type collection[E any, M any] struct {
+	// kind names the entity in diagnostics, e.g. "control policy".
+	kind     string
+	resource resource.Resource
+	list     func(ctx context.Context) ([]E, error)
+	// read reads a listed entity again, for the attributes the list leaves out. Listed entities are exported as they
+	// are when it is nil.
+	read   func(ctx context.Context, id string) (E, error)
+	id     func(entity E) string
+	name   func(entity E) string
+	update func(entity E, state *M)
+	// attached exports the resources attached to an exported entity, e.g. its namespace mappings.
+	attached func(ctx context.Context, id string, name string) diag.Diagnostics
+}
+
+func (e *exporter) collect(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, collect := range []func(context.Context) diag.Diagnostics{
+		e.collectNamespaces,
+		e.collectTemplates,
+		e.collectBlueprints,
+		e.collectStacks,
+		e.collectTeams,
+		e.collectNotificationEndpoints,
+		e.collectNotificationSlackApps,
+		e.collectControlPolicies,
+		e.collectControlPolicyGroups,
+		e.collectEventsSubscriptions,
+		e.collectVariables,
+	} {
+		diags.Append(collect(ctx)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+//region Private Methods
+
+func (e *exporter) collectNamespaces(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkNamespace.Namespace, namespace.ResourceModel]{
+		kind:     "namespace",
+		resource: provider.NewNamespaceResource(),
+		list: func(ctx context.Context) ([]*sdkNamespace.Namespace, error) {
+			return e.services.Namespace.ListNamespaces(ctx, nil, nil)
+		},
+		read:   e.services.Namespace.ReadNamespace,
+		id:     func(n *sdkNamespace.Namespace) string { return controlmonkey.StringValue(n.ID) },
+		name:   func(n *sdkNamespace.Namespace) string { return controlmonkey.StringValue(n.Name) },
+		update: namespace.UpdateStateAfterRead,
+	})
+}
+
+func (e *exporter) collectTemplates(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkTemplate.Template, template.ResourceModel]{
+		kind:     "template",
+		resource: provider.NewTemplateResource(),
+		list: func(ctx context.Context) ([]*sdkTemplate.Template, error) {
+			return e.services.Template.ListTemplates(ctx, nil, nil)
+		},
+		read:   e.services.Template.ReadTemplate,
+		id:     func(t *sdkTemplate.Template) string { return controlmonkey.StringValue(t.ID) },
+		name:   func(t *sdkTemplate.Template) string { return controlmonkey.StringValue(t.Name) },
+		update: template.UpdateStateAfterRead,
+		attached: func(ctx context.Context, id string, name string) diag.Diagnostics {
+			return collectMappings(ctx, e, provider.NewTemplateNamespaceMappingsResource(), "template-namespace mappings for template", id, name,
+				e.services.Template.ListTemplateNamespaceMappings, templateNamespaces.UpdateStateAfterRead)
+		},
+	})
+}
+
+func (e *exporter) collectBlueprints(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkBlueprint.Blueprint, tfBlueprint.ResourceModel]{
+		kind:     "blueprint",
+		resource: provider.NewBlueprintResource(),
+		list: func(ctx context.Context) ([]*sdkBlueprint.Blueprint, error) {
+			return e.services.Blueprint.ListBlueprints(ctx, nil, nil)
+		},
+		read:   e.services.Blueprint.ReadBlueprint,
+		id:     func(b *sdkBlueprint.Blueprint) string { return controlmonkey.StringValue(b.ID) },
+		name:   func(b *sdkBlueprint.Blueprint) string { return controlmonkey.StringValue(b.Name) },
+		update: tfBlueprint.UpdateStateAfterRead,
+		attached: func(ctx context.Context, id string, name string) diag.Diagnostics {
+			return collectMappings(ctx, e, provider.NewBlueprintNamespaceMappingsResource(), "blueprint-namespace mappings for blueprint", id, name,
+				e.services.Blueprint.ListBlueprintNamespaceMappings, blueprintNamespaces.UpdateStateAfterRead)
+		},
+	})
+}
+
+func (e *exporter) collectStacks(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkStack.Stack, stack.ResourceModel]{
+		kind:     "stack",
+		resource: provider.NewStackResource(),
+		list: func(ctx context.Context) ([]*sdkStack.Stack, error) {
+			return e.services.Stack.ListStacks(ctx, nil, nil, nil)
+		},
+		read:   e.services.Stack.ReadStack,
+		id:     func(s *sdkStack.Stack) string { return controlmonkey.StringValue(s.ID) },
+		name:   func(s *sdkStack.Stack) string { return controlmonkey.StringValue(s.Name) },
+		update: stack.UpdateStateAfterRead,
+	})
+}
+
+func (e *exporter) collectTeams(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkTeam.Team, team.ResourceModel]{
+		kind:     "team",
+		resource: provider.NewTeamResource(),
+		list: func(ctx context.Context) ([]*sdkTeam.Team, error) {
+			return e.services.Team.ListTeams(ctx, nil, nil)
+		},
+		read:   e.services.Team.ReadTeam,
+		id:     func(t *sdkTeam.Team) string { return controlmonkey.StringValue(t.ID) },
+		name:   func(t *sdkTeam.Team) string { return controlmonkey.StringValue(t.Name) },
+		update: team.UpdateStateAfterRead,
+		attached: func(ctx context.Context, id string, name string) diag.Diagnostics {
+			return collectMappings(ctx, e, provider.NewTeamUsersResource(), "users of team", id, name,
+				e.services.Team.ListTeamUsers, teamUsers.UpdateStateAfterRead)
+		},
+	})
+}
+
+func (e *exporter) collectNotificationEndpoints(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkNotification.Endpoint, tfNotificationEndpoint.ResourceModel]{
+		kind:     "notification endpoint",
+		resource: provider.NewNotificationEndpointResource(),
+		list: func(ctx context.Context) ([]*sdkNotification.Endpoint, error) {
+			return e.services.Notification.ListNotificationEndpoints(ctx, nil, nil)
+		},
+		read:   e.services.Notification.ReadNotificationEndpoint,
+		id:     func(n *sdkNotification.Endpoint) string { return controlmonkey.StringValue(n.ID) },
+		name:   func(n *sdkNotification.Endpoint) string { return controlmonkey.StringValue(n.Name) },
+		update: tfNotificationEndpoint.UpdateStateAfterRead,
+	})
+}
+
+// collectNotificationSlackApps exports the listed slack apps as they are, the API cannot read a single slack app.
+func (e *exporter) collectNotificationSlackApps(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkNotification.NotificationSlackApp, tfSlackApp.ResourceModel]{
+		kind:     "notification slack app",
+		resource: provider.NewNotificationSlackAppResource(),
+		list: func(ctx context.Context) ([]*sdkNotification.NotificationSlackApp, error) {
+			return e.services.Notification.ListNotificationSlackApps(ctx, nil, nil)
+		},
+		id:     func(s *sdkNotification.NotificationSlackApp) string { return controlmonkey.StringValue(s.ID) },
+		name:   func(s *sdkNotification.NotificationSlackApp) string { return controlmonkey.StringValue(s.Name) },
+		update: tfSlackApp.UpdateStateAfterRead,
+	})
+}
+
+// collectControlPolicies exports the control policies of the organization, the policies managed by ControlMonkey
+// cannot be managed by Terraform and are left out.
+func (e *exporter) collectControlPolicies(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkControlPolicy.ControlPolicy, tfControlPolicy.ResourceModel]{
+		kind:     "control policy",
+		resource: provider.NewControlPolicyResource(),
+		list: func(ctx context.Context) ([]*sdkControlPolicy.ControlPolicy, error) {
+			return e.services.ControlPolicy.ListControlPolicies(ctx, nil, nil, controlmonkey.Bool(false))
+		},
+		read:   e.services.ControlPolicy.ReadControlPolicy,
+		id:     func(p *sdkControlPolicy.ControlPolicy) string { return controlmonkey.StringValue(p.ID) },
+		name:   func(p *sdkControlPolicy.ControlPolicy) string { return controlmonkey.StringValue(p.Name) },
+		update: tfControlPolicy.UpdateStateAfterRead,
+		attached: func(ctx context.Context, id string, name string) diag.Diagnostics {
+			return collectMappings(ctx, e, provider.NewControlPolicyMappingResource(), "mappings of control policy", id, name,
+				e.services.ControlPolicy.ListControlPolicyMappings, controlPolicyMapping.UpdateStateAfterRead)
+		},
+	})
+}
+
+// collectControlPolicyGroups exports the control policy groups of the organization, the groups managed by
+// ControlMonkey cannot be managed by Terraform and are left out.
+func (e *exporter) collectControlPolicyGroups(ctx context.Context) diag.Diagnostics {
+	return collectEntities(ctx, e, collection[*sdkControlPolicyGroup.ControlPolicyGroup, tfControlPolicyGroup.ResourceModel]{
+		kind:     "control policy group",
+		resource: provider.NewControlPolicyGroupResource(),
+		list: func(ctx context.Context) ([]*sdkControlPolicyGroup.ControlPolicyGroup, error) {
+			return e.services.ControlPolicyGroup.ListControlPolicyGroups(ctx, nil, nil, controlmonkey.Bool(false))
+		},
+		read:   e.services.ControlPolicyGroup.ReadControlPolicyGroup,
+		id:     func(g *sdkControlPolicyGroup.ControlPolicyGroup) string { return controlmonkey.StringValue(g.ID) },
+		name:   func(g *sdkControlPolicyGroup.ControlPolicyGroup) string { return controlmonkey.StringValue(g.Name) },
+		update: tfControlPolicyGroup.UpdateStateAfterRead,
+		attached: func(ctx context.Context, id string, name string) diag.Diagnostics {
+			return collectMappings(ctx, e, provider.NewControlPolicyGroupMappingResource(), "mappings of control policy group", id, name,
+				e.services.ControlPolicyGroup.ListControlPolicyGroupMappings, controlPolicyGroupMapping.UpdateStateAfterRead)
+		},
+	})
+}
+
+// collectEventsSubscriptions exports the subscriptions of the organization and of every exported namespace, the
+// namespaces must be collected first.
+func (e *exporter) collectEventsSubscriptions(ctx context.Context) diag.Diagnostics {
+	type scope struct {
+		scope   string
+		scopeId *string
+		name    string
+	}
+
+	scopes := []scope{{scope: cmTypes.OrganizationScope, name: cmTypes.OrganizationScope}}
+	for _, r := range e.resourcesOfType(provider.NewNamespaceResource()) {
+		scopes = append(scopes, scope{scope: cmTypes.NamespaceScope, scopeId: controlmonkey.String(r.id), name: r.name})
+	}
+
+	for _, s := range scopes {
+		res, err := e.services.Notification.ListEventSubscriptions(ctx, s.scope, s.scopeId)
+		if err != nil {
+			return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read events subscriptions of %s", s.name), "", err)
+		}
+
+		if len(res) == 0 {
+			continue
+		}
+
+		id := s.scope
+		if s.scopeId != nil {
+			id = fmt.Sprintf("%s/%s", s.scope, *s.scopeId)
+		}
+
+		if diags := exportResource(ctx, e, provider.NewEventsSubscriptionsResource(), s.name, id, func(state *tfEventsSubscriptions.ResourceModel) {
+			tfEventsSubscriptions.UpdateStateAfterRead(res, state, s.scope, s.scopeId)
+		}); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+// collectVariables exports the variables of the organization and of every exported namespace, template and stack,
+// the scopes must be collected first. Listing a scope includes the variables it inherits, so variables are exported
+// once by id and named after the scope they belong to.
+func (e *exporter) collectVariables(ctx context.Context) diag.Diagnostics {
+	inputs := []*sdkVariable.ListVariablesInput{{OrgOnly: controlmonkey.Bool(true)}}
+	scopeNames := make(map[string]string)
+
+	for _, r := range e.resourcesOfType(provider.NewNamespaceResource()) {
+		inputs = append(inputs, &sdkVariable.ListVariablesInput{NamespaceId: controlmonkey.String(r.id)})
+		scopeNames[r.id] = r.name
+	}
+	for _, r := range e.resourcesOfType(provider.NewTemplateResource()) {
+		inputs = append(inputs, &sdkVariable.ListVariablesInput{TemplateId: controlmonkey.String(r.id)})
+		scopeNames[r.id] = r.name
+	}
+	for _, r := range e.resourcesOfType(provider.NewStackResource()) {
+		inputs = append(inputs, &sdkVariable.ListVariablesInput{StackId: controlmonkey.String(r.id)})
+		scopeNames[r.id] = r.name
+	}
+
+	exported := make(map[string]bool)
+
+	for _, input := range inputs {
+		res, err := e.services.Variable.ListVariables(ctx, input)
+		if err != nil {
+			return commons.ApiErrorDiagnostics(ctx, "Failed to list variables", "", err)
+		}
+
+		for _, v := range res.Variables {
+			id := controlmonkey.StringValue(v.ID)
+			if exported[id] {
+				continue
+			}
+			exported[id] = true
+
+			scopeName := cmTypes.OrganizationScope
+			if n, ok := scopeNames[controlmonkey.StringValue(v.ScopeId)]; ok {
+				scopeName = n
+			}
+
+			entity := &sdkVariable.ReadVariableOutput{Variable: v}
+			name := fmt.Sprintf("%s_%s", scopeName, controlmonkey.StringValue(v.Key))
+
+			diags := exportResource(ctx, e, provider.NewVariableResource(), name, id, func(state *variable.ResourceModel) {
+				variable.UpdateStateAfterRead(entity, state)
+			})
+			if diags.HasError() {
+				return diags
+			}
+
+			// The API does not return the values of sensitive variables.
+			if controlmonkey.BoolValue(v.IsSensitive) {
+				e.addInputVariable(e.resources[len(e.resources)-1], "value")
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectEntities exports every listed entity of the collection, followed by the resources attached to it.
+func collectEntities[E any, M any](ctx context.Context, e *exporter, c collection[E, M]) diag.Diagnostics {
+	res, err := c.list(ctx)
+	if err != nil {
+		return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to list entities of type %s", c.kind), "", err)
+	}
+
+	for _, entity := range res {
+		id := c.id(entity)
+
+		if c.read != nil {
+			entity, err = c.read(ctx, id)
+			if err != nil {
+				if commons.IsNotFoundResponseError(err) {
+					continue
+				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read %s %s", c.kind, id), "", err)
+			}
+		}
+
+		name := c.name(entity)
+		if diags := exportResource(ctx, e, c.resource, name, id, func(state *M) {
+			c.update(entity, state)
+		}); diags.HasError() {
+			return diags
+		}
+
+		if c.attached != nil {
+			if diags := c.attached(ctx, id, name); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectMappings exports the mappings of an exported entity under the name and id of the entity, unless it has none
+// or was deleted meanwhile.
+func collectMappings[T any, M any](ctx context.Context, e *exporter, r resource.Resource, kind string, id string, name string, list func(context.Context, string) ([]T, error), update func([]T, *M)) diag.Diagnostics {
+	mappings, err := list(ctx, id)
+	if err != nil {
+		if commons.IsNotFoundResponseError(err) {
+			return nil
+		}
+		return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read %s '%s'", kind, id), "", err)
+	}
+
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	return exportResource(ctx, e, r, name, id, func(state *M) {
+		update(mappings, state)
+	})
+}
+
+// exportResource adds a resource of the given type whose state is filled in by update, like its Read does. The state
+// starts with the id set and every other attribute null.
+func exportResource[T any](ctx context.Context, e *exporter, r resource.Resource, name string, id string, update func(*T)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metadataResp := new(resource.MetadataResponse)
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, metadataResp)
+
+	schemaResp := new(resource.SchemaResponse)
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	diags.Append(schemaResp.Diagnostics...)
+	if diags.HasError() {
+		return diags
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: initialExportValue(ctx, schemaResp.Schema, id)}
+
+	model := new(T)
+	diags.Append(state.Get(ctx, model)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	update(model)
+
+	diags.Append(state.Set(ctx, model)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	retVal := &exportedResource{
+		typeName: metadataResp.TypeName,
+		name:     e.uniqueName(metadataResp.TypeName, name),
+		id:       id,
+		schema:   schemaResp.Schema,
+		value:    state.Raw,
+	}
+
+	e.resources = append(e.resources, retVal)
+
+	// A required secret is not returned by the API, so it is left to an input variable.
+	var values map[string]tftypes.Value
+	_ = state.Raw.As(&values)
+	for name, a := range schemaResp.Schema.Attributes {
+		if a.IsSensitive() && a.IsRequired() && values[name].IsNull() {
+			e.addInputVariable(retVal, name)
+		}
+	}
+
+	if _, ok := e.references[id]; !ok && id != "" {
+		e.references[id] = retVal.address()
+	}
+
+	return diags
+}
+
+func (e *exporter) addInputVariable(r *exportedResource, attribute string) {
+	if r.inputVariables == nil {
+		r.inputVariables = make(map[string]string)
+	}
+
+	r.inputVariables[attribute] = e.uniqueName("variable", fmt.Sprintf("%s_%s", r.name, attribute))
+}
+
+func initialExportValue(ctx context.Context, s schema.Schema, id string) tftypes.Value {
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, t := range objectType.AttributeTypes {
+		if name == "id" {
+			attributes[name] = tftypes.NewValue(t, id)
+		} else {
+			attributes[name] = tftypes.NewValue(t, nil)
+		}
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func (e *exporter) resourcesOfType(r resource.Resource) []*exportedResource {
+	var retVal []*exportedResource
+
+	resp := new(resource.MetadataResponse)
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: providerTypeName}, resp)
+
+	for _, exported := range e.resources {
+		if exported.typeName == resp.TypeName {
+			retVal = append(retVal, exported)
+		}
+	}
+
+	return retVal
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueName converts a display name to a resource name that is not used yet by the resource type.
+func (e *exporter) uniqueName(typeName string, displayName string) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		name = "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	used := e.names[typeName]
+	if used == nil {
+		used = make(map[string]bool)
+		e.names[typeName] = used
+	}
+
+	retVal := name
+	for i := 2; used[retVal]; i++ {
+		retVal = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[retVal] = true
+
+	return retVal
+}
+
+//endregion
@@ -0,0 +1,198 @@
+package export
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	sdkNamespace "github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	sdkStack "github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+	sdkTemplate "github.com/control-monkey/controlmonkey-sdk-go/services/template"
+	sdkVariable "github.com/control-monkey/controlmonkey-sdk-go/services/variable"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/fake_api"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/namespace"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/variable"
+)
+
+func TestExportConfiguration(t *testing.T) {
+	server := fake_api.New()
+	defer server.Close()
+
+	namespaceId := server.Seed("/namespace", map[string]interface{}{"name": "Export Namespace"})
+	stackId := server.Seed("/stack", map[string]interface{}{
+		"name":        "Export Stack",
+		"iacType":     "terraform",
+		"namespaceId": namespaceId,
+		"data": map[string]interface{}{
+			"deploymentBehavior": map[string]interface{}{"deployOnPush": true, "waitForApproval": false},
+			"vcsInfo":            map[string]interface{}{"providerId": "vcs-export", "repoName": "acme/infra", "path": "live/prod"},
+		},
+	})
+	templateId := server.Seed("/template", map[string]interface{}{
+		"name":    "Export Template",
+		"iacType": "terraform",
+		"vcsInfo": map[string]interface{}{"providerId": "vcs-export", "repoName": "acme/infra"},
+	})
+	variableId := server.Seed("/variable", map[string]interface{}{
+		"scope": "namespace", "scopeId": namespaceId, "key": "exportVar", "type": "tfVar", "value": "exported",
+		"isSensitive": false, "isOverridable": true,
+	})
+	server.Seed("/variable", map[string]interface{}{
+		"scope": "stack", "scopeId": stackId, "key": "exportSecret", "type": "envVar",
+		"isSensitive": true, "isOverridable": false,
+	})
+
+	sess, diags := (&provider.Config{Token: fake_api.Token, Endpoint: server.URL}).Session()
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	ctx := context.Background()
+	services := NewServices(sess)
+
+	_, err := services.Template.CreateTemplateNamespaceMapping(ctx, &sdkTemplate.TemplateNamespaceMapping{
+		TemplateId:  controlmonkey.String(templateId),
+		NamespaceId: controlmonkey.String(namespaceId),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	files, diags := Configuration(ctx, services)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	assertMigratedFiles(t, files, map[string][]string{
+		"cm_namespace.tf": {
+			`resource "cm_namespace" "export_namespace" {
+  name = "Export Namespace"
+}`,
+		},
+		"cm_stack.tf": {
+			`namespace_id = cm_namespace.export_namespace.id`,
+			`path        = "live/prod"`,
+		},
+		"cm_template_namespace_mappings.tf": {
+			`resource "cm_template_namespace_mappings" "export_template" {
+  namespaces = [{
+    namespace_id = cm_namespace.export_namespace.id
+  }]
+  template_id = cm_template.export_template.id
+}`,
+		},
+		"cm_variable.tf": {
+			`resource "cm_variable" "export_namespace_exportvar" {`,
+			`scope_id       = cm_namespace.export_namespace.id`,
+			`value          = var.export_stack_exportsecret_value`,
+		},
+		"variables.tf": {
+			`variable "export_stack_exportsecret_value" {
+  type      = string
+  sensitive = true
+}`,
+		},
+		"imports.tf": {
+			`import {
+  to = cm_namespace.export_namespace
+  id = "` + namespaceId + `"
+}`,
+			`import {
+  to = cm_template_namespace_mappings.export_template
+  id = "` + templateId + `"
+}`,
+			`import {
+  to = cm_variable.export_namespace_exportvar
+  id = "` + variableId + `"
+}`,
+		},
+		"provider.tf": {
+			`source = "control-monkey/cm"`,
+			`provider "cm" {`,
+		},
+	})
+}
+
+func TestExportRender(t *testing.T) {
+	ctx := context.Background()
+
+	for _, imports := range []bool{true, false} {
+		e := &exporter{imports: imports, names: make(map[string]map[string]bool), references: make(map[string]string)}
+
+		diags := exportResource(ctx, e, provider.NewNamespaceResource(), "Prod", "ns-prod", func(state *namespace.ResourceModel) {
+			namespace.UpdateStateAfterRead(&sdkNamespace.Namespace{ID: controlmonkey.String("ns-prod"), Name: controlmonkey.String("Prod")}, state)
+		})
+		diags.Append(exportResource(ctx, e, provider.NewStackResource(), "Other", "stk-other", func(state *stack.ResourceModel) {
+			stack.UpdateStateAfterRead(&sdkStack.Stack{
+				ID:          controlmonkey.String("stk-other"),
+				Name:        controlmonkey.String("Other"),
+				IacType:     controlmonkey.String("terraform"),
+				NamespaceId: controlmonkey.String("ns-not-exported"),
+				Data: &sdkStack.Data{
+					VcsInfo: &sdkStack.VcsInfo{ProviderId: controlmonkey.String("vcs-export"), RepoName: controlmonkey.String("acme/infra")},
+				},
+			}, state)
+		})...)
+		diags.Append(exportResource(ctx, e, provider.NewVariableResource(), "Prod Id", "var-prod-id", func(state *variable.ResourceModel) {
+			variable.UpdateStateAfterRead(&sdkVariable.ReadVariableOutput{Variable: &sdkVariable.Variable{
+				ID:            controlmonkey.String("var-prod-id"),
+				Scope:         controlmonkey.String("namespace"),
+				ScopeId:       controlmonkey.String("ns-prod"),
+				Key:           controlmonkey.String("prodId"),
+				Type:          controlmonkey.String("tfVar"),
+				Value:         controlmonkey.String("ns-prod"),
+				IsSensitive:   controlmonkey.Bool(false),
+				IsOverridable: controlmonkey.Bool(false),
+			}}, state)
+		})...)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		files := e.render()
+
+		assertMigratedFiles(t, files, map[string][]string{
+			"cm_namespace.tf": {`resource "cm_namespace" "prod" {`},
+			// An id that is not exported stays a literal.
+			"cm_stack.tf": {`namespace_id = "ns-not-exported"`},
+			// Only attributes holding ids refer to exported resources.
+			"cm_variable.tf": {
+				`scope_id       = cm_namespace.prod.id`,
+				`value          = "ns-prod"`,
+			},
+		})
+
+		if _, ok := files[exportImportsFile]; ok != imports {
+			t.Errorf("expected imports.tf to be generated %t, got %t", imports, ok)
+		}
+		if imports && strings.Count(string(files[exportImportsFile]), "import {") != 3 {
+			t.Errorf("expected an import block for every resource:\n%s", files[exportImportsFile])
+		}
+		if _, ok := files[exportVariablesFile]; ok {
+			t.Errorf("expected no variables.tf without secrets:\n%s", files[exportVariablesFile])
+		}
+	}
+}
+
+func TestExportUniqueName(t *testing.T) {
+	e := &exporter{names: make(map[string]map[string]bool)}
+
+	for _, tc := range []struct {
+		typeName    string
+		displayName string
+		expected    string
+	}{
+		{typeName: "cm_stack", displayName: "Prod Stack", expected: "prod_stack"},
+		{typeName: "cm_stack", displayName: "prod-stack", expected: "prod_stack_2"},
+		{typeName: "cm_namespace", displayName: "Prod Stack", expected: "prod_stack"},
+		{typeName: "cm_stack", displayName: "1st/stack", expected: "_1st_stack"},
+		{typeName: "cm_stack", displayName: "!!!", expected: "unnamed"},
+	} {
+		if actual := e.uniqueName(tc.typeName, tc.displayName); actual != tc.expected {
+			t.Errorf("uniqueName(%q, %q) = %q, expected %q", tc.typeName, tc.displayName, actual, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package export
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	exportProviderFile  = "provider.tf"
+	exportImportsFile   = "imports.tf"
+	exportVariablesFile = "variables.tf"
+)
+
+// render writes the collected resources to a file per resource type, in the order they were collected.
+func (e *exporter) render() map[string][]byte {
+	files := make(map[string]*hclwrite.File)
+	file := func(name string) *hclwrite.File {
+		if _, ok := files[name]; !ok {
+			files[name] = hclwrite.NewEmptyFile()
+		}
+		return files[name]
+	}
+
+	providerBody := file(exportProviderFile).Body()
+	requiredProviders := providerBody.AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	requiredProviders.SetAttributeValue(providerTypeName, cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("control-monkey/cm"),
+	}))
+	providerBody.AppendNewline()
+	providerBody.AppendNewBlock("provider", []string{providerTypeName})
+
+	for _, r := range e.resources {
+		body := file(r.typeName + ".tf").Body()
+		if len(body.Blocks()) > 0 {
+			body.AppendNewline()
+		}
+
+		resourceBody := body.AppendNewBlock("resource", []string{r.typeName, r.name}).Body()
+		e.writeBody(resourceBody, r.schema.Attributes, r.schema.Blocks, r.value, r.inputVariables)
+
+		if e.imports {
+			importsBody := file(exportImportsFile).Body()
+			if len(importsBody.Blocks()) > 0 {
+				importsBody.AppendNewline()
+			}
+
+			importBody := importsBody.AppendNewBlock("import", nil).Body()
+			importBody.SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: r.typeName},
+				hcl.TraverseAttr{Name: r.name},
+			})
+			importBody.SetAttributeValue("id", cty.StringVal(r.id))
+		}
+
+		for _, inputVariable := range helpers.SortedKeys(r.inputVariables) {
+			variablesBody := file(exportVariablesFile).Body()
+			if len(variablesBody.Blocks()) > 0 {
+				variablesBody.AppendNewline()
+			}
+
+			variableBody := variablesBody.AppendNewBlock("variable", []string{r.inputVariables[inputVariable]}).Body()
+			variableBody.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+			variableBody.SetAttributeValue("sensitive", cty.True)
+		}
+	}
+
+	retVal := make(map[string][]byte, len(files))
+	for name, f := range files {
+		retVal[name] = f.Bytes()
+	}
+
+	return retVal
+}
+
+//region Private Methods
+
+// writeBody writes the attributes that can be configured and are not null, the id and the timeouts are left out.
+func (e *exporter) writeBody(body *hclwrite.Body, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value, inputVariables map[string]string) {
+	var values map[string]tftypes.Value
+	_ = value.As(&values)
+
+	for _, name := range helpers.SortedKeys(attributes) {
+		if inputVariable, ok := inputVariables[name]; ok {
+			body.SetAttributeTraversal(name, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: inputVariable}})
+			continue
+		}
+
+		a := attributes[name]
+		v := values[name]
+		if name == "id" || isComputedOnly(a) || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		body.SetAttributeRaw(name, e.attributeTokens(name, a, v))
+	}
+
+	for _, name := range helpers.SortedKeys(blocks) {
+		v := values[name]
+		if name == "timeouts" || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		b := blocks[name]
+		nested := b.GetNestedObject()
+		nestedAttributes := toAttributes(nested.GetAttributes())
+		nestedBlocks := make(map[string]schema.Block, len(nested.GetBlocks()))
+		for k, nb := range nested.GetBlocks() {
+			nestedBlocks[k] = nb
+		}
+
+		var elements []tftypes.Value
+		if v.Type().Is(tftypes.Object{}) {
+			elements = []tftypes.Value{v}
+		} else {
+			_ = v.As(&elements)
+		}
+
+		for _, element := range elements {
+			e.writeBody(body.AppendNewBlock(name, nil).Body(), nestedAttributes, nestedBlocks, element, nil)
+		}
+	}
+}
+
+func (e *exporter) attributeTokens(name string, a schema.Attribute, v tftypes.Value) hclwrite.Tokens {
+	nestedAttribute, ok := a.(schema.NestedAttribute)
+	if !ok {
+		return e.valueTokens(name, v)
+	}
+
+	attributes := toAttributes(nestedAttribute.GetNestedObject().GetAttributes())
+
+	if v.Type().Is(tftypes.Object{}) {
+		return e.objectTokens(attributes, v)
+	}
+
+	if v.Type().Is(tftypes.Map{}) {
+		var elements map[string]tftypes.Value
+		_ = v.As(&elements)
+
+		var items []hclwrite.ObjectAttrTokens
+		for _, k := range helpers.SortedKeys(elements) {
+			items = append(items, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForValue(cty.StringVal(k)), Value: e.objectTokens(attributes, elements[k])})
+		}
+		return hclwrite.TokensForObject(items)
+	}
+
+	var elements []tftypes.Value
+	_ = v.As(&elements)
+
+	var items []hclwrite.Tokens
+	for _, element := range elements {
+		items = append(items, e.objectTokens(attributes, element))
+	}
+	return hclwrite.TokensForTuple(items)
+}
+
+func (e *exporter) objectTokens(attributes map[string]schema.Attribute, v tftypes.Value) hclwrite.Tokens {
+	var values map[string]tftypes.Value
+	_ = v.As(&values)
+
+	var items []hclwrite.ObjectAttrTokens
+	for _, name := range helpers.SortedKeys(attributes) {
+		a := attributes[name]
+		attributeValue := values[name]
+		if name == "id" || isComputedOnly(a) || attributeValue.IsNull() || !attributeValue.IsKnown() {
+			continue
+		}
+
+		items = append(items, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: e.attributeTokens(name, a, attributeValue)})
+	}
+
+	return hclwrite.TokensForObject(items)
+}
+
+// valueTokens returns the tokens of a value that is not a nested attribute. A string of an attribute that holds ids
+// is replaced by a reference when it is the id of an exported resource.
+func (e *exporter) valueTokens(name string, v tftypes.Value) hclwrite.Tokens {
+	if v.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+
+		if address, ok := e.references[s]; ok && (strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_ids")) {
+			traversal := hcl.Traversal{}
+			for i, part := range strings.Split(address+".id", ".") {
+				if i == 0 {
+					traversal = append(traversal, hcl.TraverseRoot{Name: part})
+				} else {
+					traversal = append(traversal, hcl.TraverseAttr{Name: part})
+				}
+			}
+			return hclwrite.TokensForTraversal(traversal)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s))
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		_ = v.As(&n)
+		return hclwrite.TokensForValue(cty.NumberVal(n))
+	case v.Type().Is(tftypes.Map{}) || v.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		_ = v.As(&elements)
+
+		var items []hclwrite.ObjectAttrTokens
+		for _, k := range helpers.SortedKeys(elements) {
+			key := hclwrite.TokensForValue(cty.StringVal(k))
+			if hclsyntax.ValidIdentifier(k) {
+				key = hclwrite.TokensForIdentifier(k)
+			}
+			items = append(items, hclwrite.ObjectAttrTokens{Name: key, Value: e.valueTokens(k, elements[k])})
+		}
+		return hclwrite.TokensForObject(items)
+	default:
+		var elements []tftypes.Value
+		_ = v.As(&elements)
+
+		var items []hclwrite.Tokens
+		for _, element := range elements {
+			items = append(items, e.valueTokens(name, element))
+		}
+		return hclwrite.TokensForTuple(items)
+	}
+}
+
+func isComputedOnly(a schema.Attribute) bool {
+	return a.IsComputed() && !a.IsOptional() && !a.IsRequired()
+}
+
+func toAttributes[T schema.Attribute](attributes map[string]T) map[string]schema.Attribute {
+	retVal := make(map[string]schema.Attribute, len(attributes))
+	for k, v := range attributes {
+		retVal[k] = v
+	}
+
+	return retVal
+}
+
+//endregion
@@ -0,0 +1,222 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack"
+	tfStackDependency "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack_dependency"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/variable"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MigrationOptions holds the ControlMonkey settings of the migrated stacks, which the source configuration does not
+// have.
+type MigrationOptions struct {
+	// NamespaceId is the namespace the stacks are created in.
+	NamespaceId string
+	// VcsProviderId is the ControlMonkey VCS provider of the repositories.
+	VcsProviderId string
+	// RepoName is the repository of the stacks when the source configuration does not name it.
+	RepoName string
+}
+
+func (o MigrationOptions) validate() diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if o.NamespaceId == "" {
+		retVal.AddError(validationError, "The namespace id of the migrated stacks is required")
+	}
+	if o.VcsProviderId == "" {
+		retVal.AddError(validationError, "The VCS provider id of the migrated stacks is required")
+	}
+
+	return retVal
+}
+
+// migratedStack is a stack converted from the source configuration.
+type migratedStack struct {
+	// id identifies the stack in the source configuration.
+	id                 string
+	name               string
+	description        *string
+	iacType            string
+	repoName           string
+	path               string
+	branch             string
+	iacVersion         string
+	deployOnPush       bool
+	autoApprove        bool
+	runTriggerPatterns []string
+	dependsOnStackIds  []string
+	variables          []*migratedVariable
+}
+
+type migratedVariable struct {
+	key          string
+	value        *string
+	variableType string
+	isSensitive  bool
+	description  *string
+}
+
+// newMigrationExporter returns an exporter that writes the migrated stacks without import blocks, their variables and
+// dependencies refer to them by their id in the source configuration.
+func newMigrationExporter() *exporter {
+	return &exporter{
+		names:      make(map[string]map[string]bool),
+		references: make(map[string]string),
+	}
+}
+
+//region Private Methods
+
+// migrateStacks adds a cm_stack for every stack, followed by their variables and dependencies. A dependency on a
+// stack that is not migrated is left out with a warning. Variables are named after their stack and key, which may have
+// characters that are not valid in a resource name, such as '-' or '.', so exportResource converts the names to unique
+// identifiers.
+func (e *exporter) migrateStacks(ctx context.Context, stacks []*migratedStack, options MigrationOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, s := range stacks {
+		diags.Append(e.migrateStack(ctx, s, options)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, s := range stacks {
+		stackAddress, ok := e.references[s.id]
+		if !ok {
+			continue
+		}
+		stackName := strings.TrimPrefix(stackAddress, "cm_stack.")
+
+		for _, v := range s.variables {
+			diags.Append(exportResource(ctx, e, provider.NewVariableResource(), fmt.Sprintf("%s_%s", stackName, v.key), "", func(state *variable.ResourceModel) {
+				state.Scope = types.StringValue(cmTypes.StackScope)
+				state.ScopeId = types.StringValue(s.id)
+				state.Key = types.StringValue(v.key)
+				state.Type = types.StringValue(v.variableType)
+				state.Value = types.StringPointerValue(v.value)
+				state.IsSensitive = types.BoolValue(v.isSensitive)
+				state.IsOverridable = types.BoolValue(false)
+				state.Description = helpers.StringValueIfNotEqual(v.description, "")
+			})...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// The values of sensitive variables are not exported by the source either.
+			if v.isSensitive && v.value == nil {
+				e.addInputVariable(e.resources[len(e.resources)-1], "value")
+			}
+		}
+
+		for _, dependsOnStackId := range s.dependsOnStackIds {
+			dependsOnAddress, ok := e.references[dependsOnStackId]
+			if !ok {
+				diags.AddWarning("Dependency not migrated", fmt.Sprintf("Stack '%s' depends on '%s', which is not migrated", s.name, dependsOnStackId))
+				continue
+			}
+
+			name := fmt.Sprintf("%s_%s", stackName, strings.TrimPrefix(dependsOnAddress, "cm_stack."))
+			diags.Append(exportResource(ctx, e, provider.NewStackDependencyResource(), name, "", func(state *tfStackDependency.ResourceModel) {
+				state.StackId = types.StringValue(s.id)
+				state.DependsOnStackId = types.StringValue(dependsOnStackId)
+			})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+func (e *exporter) migrateStack(ctx context.Context, s *migratedStack, options MigrationOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	repoName := s.repoName
+	if repoName == "" {
+		repoName = options.RepoName
+	}
+	if repoName == "" {
+		diags.AddWarning("Stack not migrated", fmt.Sprintf("The repository of stack '%s' is unknown, set the repository name of the migration", s.name))
+		return diags
+	}
+
+	iacVersion := types.StringNull()
+	if s.iacVersion != "" {
+		if isIacVersion(s.iacVersion) {
+			iacVersion = types.StringValue(strings.TrimPrefix(s.iacVersion, "v"))
+		} else {
+			diags.AddWarning("IaC version not migrated", fmt.Sprintf("Version '%s' of stack '%s' is not a version or a version constraint", s.iacVersion, s.name))
+		}
+	}
+
+	rule := cmTypes.RequireApproval
+	if s.autoApprove {
+		rule = cmTypes.AutoApprove
+	}
+
+	diags.Append(exportResource(ctx, e, provider.NewStackResource(), s.name, s.id, func(state *stack.ResourceModel) {
+		state.IacType = types.StringValue(s.iacType)
+		state.NamespaceId = types.StringValue(options.NamespaceId)
+		state.Name = types.StringValue(s.name)
+		state.Description = helpers.StringValueIfNotEqual(s.description, "")
+		state.DeploymentBehavior = &cross_models.DeploymentBehaviorModel{
+			DeployOnPush: types.BoolValue(s.deployOnPush),
+		}
+		state.DeploymentApprovalPolicy = &cross_models.DeploymentApprovalPolicyModel{
+			Rules: []*cross_models.DeploymentApprovalPolicyRuleModel{
+				{Type: types.StringValue(rule), Parameters: jsontypes.NewNormalizedNull()},
+			},
+		}
+		state.VcsInfo = &stack.VcsInfoModel{
+			ProviderId: types.StringValue(options.VcsProviderId),
+			RepoName:   types.StringValue(repoName),
+			Path:       helpers.StringValueIfNotEqual(&s.path, ""),
+			Branch:     helpers.StringValueIfNotEqual(&s.branch, ""),
+		}
+
+		if iacVersion.IsNull() == false {
+			iacConfig := &cross_models.IacConfigModel{VarFiles: types.ListNull(types.StringType)}
+			if s.iacType == cmTypes.Opentofu {
+				iacConfig.OpentofuVersion = iacVersion
+			} else {
+				iacConfig.TerraformVersion = iacVersion
+			}
+			state.IacConfig = iacConfig
+		}
+
+		if len(s.runTriggerPatterns) > 0 {
+			patterns, _ := types.ListValueFrom(ctx, types.StringType, s.runTriggerPatterns)
+			state.RunTrigger = &cross_models.RunTriggerModel{
+				Patterns:        patterns,
+				ExcludePatterns: types.ListNull(types.StringType),
+			}
+		}
+	})...)
+
+	return diags
+}
+
+func isIacVersion(s string) bool {
+	if _, err := version.NewVersion(s); err == nil {
+		return true
+	}
+
+	_, err := version.NewConstraint(s)
+	return err == nil
+}
+
+//endregion
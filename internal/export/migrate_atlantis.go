@@ -0,0 +1,137 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	atlantisDefaultWorkspace     = "default"
+	atlantisOpentofuDistribution = "opentofu"
+)
+
+// MigrateAtlantisConfig converts the projects of an atlantis.yaml repo config to stacks of the repository of the
+// options.
+//
+// Every project becomes a cm_stack: its dir is the path of the stack, its terraform_version the version in iac_config
+// and its autoplan when_modified patterns become run_trigger patterns, relative to the root of the repository. Atlantis
+// applies only when asked to in a pull request, so deployments wait for approval. The depends_on of a project become
+// cm_stack_dependency resources.
+func MigrateAtlantisConfig(ctx context.Context, config []byte, options MigrationOptions) (map[string][]byte, diag.Diagnostics) {
+	diags := options.validate()
+	if options.RepoName == "" {
+		diags.AddError(validationError, "The repository name of the migrated stacks is required")
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var c atlantisConfig
+	if err := yaml.Unmarshal(config, &c); err != nil {
+		diags.AddError(validationError, fmt.Sprintf("Failed to parse the Atlantis config: %s", err))
+		return nil, diags
+	}
+
+	stacks, d := atlantisStacks(&c)
+	diags.Append(d...)
+
+	e := newMigrationExporter()
+	diags.Append(e.migrateStacks(ctx, stacks, options)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return e.render(), diags
+}
+
+type atlantisConfig struct {
+	Projects []*atlantisProject `yaml:"projects"`
+}
+
+type atlantisProject struct {
+	Name                  string   `yaml:"name"`
+	Dir                   string   `yaml:"dir"`
+	Workspace             string   `yaml:"workspace"`
+	TerraformVersion      string   `yaml:"terraform_version"`
+	TerraformDistribution string   `yaml:"terraform_distribution"`
+	DependsOn             []string `yaml:"depends_on"`
+	Autoplan              *struct {
+		Enabled      *bool    `yaml:"enabled"`
+		WhenModified []string `yaml:"when_modified"`
+	} `yaml:"autoplan"`
+}
+
+//region Private Methods
+
+func atlantisStacks(c *atlantisConfig) ([]*migratedStack, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var retVal []*migratedStack
+
+	for _, p := range c.Projects {
+		dir := path.Clean(strings.TrimPrefix(p.Dir, "/"))
+		if dir == "." {
+			dir = ""
+		}
+
+		name := p.Name
+		if name == "" {
+			name = dir
+			if name == "" {
+				name = "root"
+			}
+			if p.Workspace != "" && p.Workspace != atlantisDefaultWorkspace {
+				name = fmt.Sprintf("%s-%s", name, p.Workspace)
+			}
+		}
+
+		s := &migratedStack{
+			id:           atlantisProjectId(name),
+			name:         name,
+			iacType:      cmTypes.Terraform,
+			path:         dir,
+			iacVersion:   p.TerraformVersion,
+			deployOnPush: true,
+		}
+
+		if p.TerraformDistribution == atlantisOpentofuDistribution {
+			s.iacType = cmTypes.Opentofu
+		}
+
+		if p.Autoplan != nil {
+			if p.Autoplan.Enabled != nil && *p.Autoplan.Enabled == false {
+				s.deployOnPush = false
+			}
+
+			for _, pattern := range p.Autoplan.WhenModified {
+				// The patterns of Atlantis are relative to the dir of the project.
+				joined := path.Join(dir, pattern)
+				if joined == ".." || strings.HasPrefix(joined, "../") {
+					diags.AddWarning("Pattern not migrated", fmt.Sprintf("Pattern '%s' of project '%s' is outside of the repository", pattern, name))
+					continue
+				}
+				s.runTriggerPatterns = append(s.runTriggerPatterns, joined)
+			}
+		}
+
+		for _, dependsOn := range p.DependsOn {
+			s.dependsOnStackIds = append(s.dependsOnStackIds, atlantisProjectId(dependsOn))
+		}
+
+		retVal = append(retVal, s)
+	}
+
+	return retVal, diags
+}
+
+// atlantisProjectId returns the id a project is referred to by, which cannot be mistaken for a ControlMonkey id.
+func atlantisProjectId(name string) string {
+	return "atlantis-project:" + name
+}
+
+//endregion
@@ -0,0 +1,213 @@
+package export
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+var testMigrationOptions = MigrationOptions{
+	NamespaceId:   "ns-migrate",
+	VcsProviderId: "vcs-migrate",
+}
+
+func TestMigrateTfcWorkspaces(t *testing.T) {
+	workspaces := `{"data": [
+  {"id": "ws-network", "type": "workspaces", "attributes": {
+    "name": "network", "terraform-version": "1.5.7", "auto-apply": true, "working-directory": "live/network",
+    "trigger-prefixes": ["modules/vpc/"], "vcs-repo": {"identifier": "acme/infra", "branch": "main"}}},
+  {"id": "ws-app", "type": "workspaces", "attributes": {
+    "name": "app", "description": "The app", "terraform-version": "latest", "auto-apply": false,
+    "working-directory": "/live/app", "trigger-patterns": ["/modules/app/**/*.tf"]}}
+]}`
+	vars := `{"data": [
+  {"id": "var-region", "type": "vars", "attributes": {"key": "region", "value": "us-east-1", "category": "terraform", "sensitive": false},
+    "relationships": {"configurable": {"data": {"id": "ws-network", "type": "workspaces"}}}},
+  {"id": "var-secret", "type": "vars", "attributes": {"key": "AWS_SECRET", "value": null, "category": "env", "sensitive": true},
+    "relationships": {"configurable": {"data": {"id": "ws-app", "type": "workspaces"}}}},
+  {"id": "var-policy", "type": "vars", "attributes": {"key": "policy", "value": "x", "category": "policy-set", "sensitive": false},
+    "relationships": {"configurable": {"data": {"id": "ws-app", "type": "workspaces"}}}}
+]}`
+	runTriggers := `{"data": {"id": "rt-app", "type": "run-triggers", "relationships": {
+  "workspace": {"data": {"id": "ws-app", "type": "workspaces"}},
+  "sourceable": {"data": {"id": "ws-network", "type": "workspaces"}}}}}`
+
+	options := testMigrationOptions
+	options.RepoName = "acme/default"
+
+	files, diags := MigrateTfcWorkspaces(context.Background(), [][]byte{[]byte(workspaces), []byte(vars), []byte(runTriggers)}, options)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if diags.WarningsCount() != 2 {
+		t.Errorf("expected warnings of the version of 'app' and the policy-set variable, got %v", diags)
+	}
+
+	assertMigratedFiles(t, files, map[string][]string{
+		"cm_stack.tf": {
+			`resource "cm_stack" "network"`,
+			`namespace_id = "ns-migrate"`,
+			`provider_id = "vcs-migrate"`,
+			`repo_name   = "acme/infra"`,
+			`branch      = "main"`,
+			`path        = "live/network"`,
+			`terraform_version = "1.5.7"`,
+			`type = "autoApprove"`,
+			`patterns = ["modules/vpc/**/*"]`,
+			`resource "cm_stack" "app"`,
+			`description  = "The app"`,
+			`repo_name   = "acme/default"`,
+			`path        = "live/app"`,
+			`type = "requireApproval"`,
+			`patterns = ["modules/app/**/*.tf"]`,
+		},
+		"cm_variable.tf": {
+			`resource "cm_variable" "network_region"`,
+			`scope_id       = cm_stack.network.id`,
+			`type           = "tfVar"`,
+			`value          = "us-east-1"`,
+			`resource "cm_variable" "app_aws_secret"`,
+			`scope_id       = cm_stack.app.id`,
+			`type           = "envVar"`,
+			`value          = var.app_aws_secret_value`,
+		},
+		"cm_stack_dependency.tf": {
+			`resource "cm_stack_dependency" "app_network"`,
+			`depends_on_stack_id = cm_stack.network.id`,
+			`stack_id            = cm_stack.app.id`,
+		},
+		"variables.tf": {
+			`variable "app_aws_secret_value"`,
+			`sensitive = true`,
+		},
+	})
+
+	if _, ok := files[exportImportsFile]; ok {
+		t.Errorf("expected no import blocks for migrated stacks")
+	}
+	if strings.Contains(string(files["cm_variable.tf"]), "policy") {
+		t.Errorf("expected the policy-set variable not to be migrated")
+	}
+}
+
+func TestMigrateAtlantisConfig(t *testing.T) {
+	config := `version: 3
+projects:
+- name: network
+  dir: live/network
+  terraform_version: v1.5.7
+  autoplan:
+    when_modified: ["*.tf", "../../modules/vpc/**/*.tf", "../../../outside/*"]
+- dir: live/app
+  workspace: staging
+  terraform_distribution: opentofu
+  terraform_version: 1.6.0
+  depends_on: [network, missing]
+- name: manual
+  dir: live/manual
+  autoplan:
+    enabled: false
+`
+
+	options := testMigrationOptions
+	options.RepoName = "acme/infra"
+
+	files, diags := MigrateAtlantisConfig(context.Background(), []byte(config), options)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if diags.WarningsCount() != 2 {
+		t.Errorf("expected warnings of the pattern outside of the repository and the missing dependency, got %v", diags)
+	}
+
+	assertMigratedFiles(t, files, map[string][]string{
+		"cm_stack.tf": {
+			`resource "cm_stack" "network"`,
+			`terraform_version = "1.5.7"`,
+			`patterns = ["live/network/*.tf", "modules/vpc/**/*.tf"]`,
+			`resource "cm_stack" "live_app_staging"`,
+			`name         = "live/app-staging"`,
+			`iac_type     = "opentofu"`,
+			`opentofu_version = "1.6.0"`,
+			`resource "cm_stack" "manual"`,
+			`deploy_on_push = false`,
+			`type = "requireApproval"`,
+		},
+		"cm_stack_dependency.tf": {
+			`resource "cm_stack_dependency" "live_app_staging_network"`,
+			`depends_on_stack_id = cm_stack.network.id`,
+			`stack_id            = cm_stack.live_app_staging.id`,
+		},
+	})
+
+	_, diags = MigrateAtlantisConfig(context.Background(), []byte(config), testMigrationOptions)
+	if !diags.HasError() {
+		t.Errorf("expected an error without a repository name")
+	}
+}
+
+func TestMigrateVariableNames(t *testing.T) {
+	secret := "secret"
+	stacks := []*migratedStack{
+		{id: "web", name: "web", iacType: "terraform", repoName: "acme/infra", variables: []*migratedVariable{
+			{key: "app-name", value: &secret, variableType: "tfVar"},
+			{key: "app.name", value: &secret, variableType: "tfVar"},
+			{key: "APP_NAME", variableType: "envVar", isSensitive: true},
+			{key: "1st", value: &secret, variableType: "tfVar"},
+		}},
+		{id: "web-app", name: "web-app", iacType: "terraform", repoName: "acme/infra", variables: []*migratedVariable{
+			{key: "name", value: &secret, variableType: "tfVar"},
+		}},
+	}
+
+	e := newMigrationExporter()
+	if diags := e.migrateStacks(context.Background(), stacks, testMigrationOptions); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	files := e.render()
+	for name, content := range files {
+		if _, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("file %s is not valid HCL: %s", name, diags.Error())
+		}
+	}
+
+	assertMigratedFiles(t, files, map[string][]string{
+		"cm_variable.tf": {
+			`resource "cm_variable" "web_app_name" {`,
+			`key            = "app-name"`,
+			`resource "cm_variable" "web_app_name_2" {`,
+			`key            = "app.name"`,
+			`resource "cm_variable" "web_app_name_3" {`,
+			`key            = "APP_NAME"`,
+			`value          = var.web_app_name_3_value`,
+			`resource "cm_variable" "web_1st" {`,
+			`resource "cm_variable" "web_app_name_4" {`,
+			`scope_id       = cm_stack.web_app.id`,
+		},
+		"variables.tf": {
+			`variable "web_app_name_3_value"`,
+		},
+	})
+}
+
+func assertMigratedFiles(t *testing.T, files map[string][]byte, expected map[string][]string) {
+	t.Helper()
+
+	for name, texts := range expected {
+		content, ok := files[name]
+		if !ok {
+			t.Errorf("file %s was not generated", name)
+			continue
+		}
+
+		for _, text := range texts {
+			if !strings.Contains(string(content), text) {
+				t.Errorf("file %s does not contain '%s':\n%s", name, text, content)
+			}
+		}
+	}
+}
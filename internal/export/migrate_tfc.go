@@ -0,0 +1,258 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	tfcWorkspacesType  = "workspaces"
+	tfcVarsType        = "vars"
+	tfcRunTriggersType = "run-triggers"
+
+	tfcTerraformCategory = "terraform"
+	tfcEnvCategory       = "env"
+)
+
+// MigrateTfcWorkspaces converts Terraform Cloud workspaces to stacks. The documents are responses of the Terraform
+// Cloud API saved to files: the workspaces, the variables of the workspaces and their inbound run triggers, in any
+// order and split into any number of documents.
+//
+// Every workspace becomes a cm_stack: its working directory is the path of the stack, its Terraform version the
+// version in iac_config, auto-apply selects the approval rule of the deployment and its trigger prefixes and patterns
+// become run_trigger patterns. Workspace variables become cm_variable resources of the stack and run triggers become
+// cm_stack_dependency resources.
+func MigrateTfcWorkspaces(ctx context.Context, documents [][]byte, options MigrationOptions) (map[string][]byte, diag.Diagnostics) {
+	diags := options.validate()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var resources []*tfcResource
+	for i, document := range documents {
+		r, err := parseTfcDocument(document)
+		if err != nil {
+			diags.AddError(validationError, fmt.Sprintf("Failed to parse Terraform Cloud document %d: %s", i+1, err))
+			return nil, diags
+		}
+		resources = append(resources, r...)
+	}
+
+	stacks, d := tfcStacks(resources)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	e := newMigrationExporter()
+	diags.Append(e.migrateStacks(ctx, stacks, options)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return e.render(), diags
+}
+
+// tfcResource is a resource of a JSON:API document of the Terraform Cloud API.
+type tfcResource struct {
+	ID            string                              `json:"id"`
+	Type          string                              `json:"type"`
+	Attributes    json.RawMessage                     `json:"attributes"`
+	Relationships map[string]*tfcResourceRelationship `json:"relationships"`
+}
+
+type tfcResourceRelationship struct {
+	Data json.RawMessage `json:"data"`
+}
+
+type tfcResourceIdentifier struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type tfcWorkspaceAttributes struct {
+	Name                string   `json:"name"`
+	Description         *string  `json:"description"`
+	TerraformVersion    string   `json:"terraform-version"`
+	AutoApply           bool     `json:"auto-apply"`
+	WorkingDirectory    string   `json:"working-directory"`
+	FileTriggersEnabled *bool    `json:"file-triggers-enabled"`
+	TriggerPrefixes     []string `json:"trigger-prefixes"`
+	TriggerPatterns     []string `json:"trigger-patterns"`
+	VcsRepo             *struct {
+		Identifier string `json:"identifier"`
+		Branch     string `json:"branch"`
+	} `json:"vcs-repo"`
+}
+
+type tfcVarAttributes struct {
+	Key         string  `json:"key"`
+	Value       *string `json:"value"`
+	Category    string  `json:"category"`
+	Sensitive   bool    `json:"sensitive"`
+	Description *string `json:"description"`
+}
+
+//region Private Methods
+
+// parseTfcDocument returns the primary and the included resources of the document, whose data is a resource or a
+// list of resources.
+func parseTfcDocument(document []byte) ([]*tfcResource, error) {
+	var d struct {
+		Data     json.RawMessage `json:"data"`
+		Included []*tfcResource  `json:"included"`
+	}
+	if err := json.Unmarshal(document, &d); err != nil {
+		return nil, err
+	}
+
+	var retVal []*tfcResource
+
+	data := bytes.TrimSpace(d.Data)
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &retVal); err != nil {
+			return nil, err
+		}
+	} else if len(data) > 0 && data[0] == '{' {
+		r := new(tfcResource)
+		if err := json.Unmarshal(data, r); err != nil {
+			return nil, err
+		}
+		retVal = append(retVal, r)
+	} else {
+		return nil, fmt.Errorf("the document has no data")
+	}
+
+	return append(retVal, d.Included...), nil
+}
+
+// relationship returns the id of the resource the relationship refers to, or an empty string when it does not refer
+// to a single resource.
+func (r *tfcResource) relationship(name string) string {
+	relationship := r.Relationships[name]
+	if relationship == nil {
+		return ""
+	}
+
+	var identifier tfcResourceIdentifier
+	if err := json.Unmarshal(relationship.Data, &identifier); err != nil {
+		return ""
+	}
+
+	return identifier.ID
+}
+
+func tfcStacks(resources []*tfcResource) ([]*migratedStack, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var retVal []*migratedStack
+
+	byWorkspaceId := make(map[string]*migratedStack)
+	seen := make(map[string]bool)
+
+	for _, r := range resources {
+		if r.Type != tfcWorkspacesType || seen[r.ID] {
+			continue
+		}
+		seen[r.ID] = true
+
+		var attributes tfcWorkspaceAttributes
+		if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+			diags.AddError(validationError, fmt.Sprintf("Failed to parse workspace %s: %s", r.ID, err))
+			return nil, diags
+		}
+
+		s := &migratedStack{
+			id:          r.ID,
+			name:        attributes.Name,
+			description: attributes.Description,
+			iacType:     cmTypes.Terraform,
+			path:        strings.Trim(attributes.WorkingDirectory, "/"),
+			iacVersion:  attributes.TerraformVersion,
+			autoApprove: attributes.AutoApply,
+		}
+
+		if attributes.VcsRepo != nil {
+			s.repoName = attributes.VcsRepo.Identifier
+			s.branch = attributes.VcsRepo.Branch
+			s.deployOnPush = true
+		}
+
+		if attributes.FileTriggersEnabled != nil && *attributes.FileTriggersEnabled == false {
+			// Every change to the repository queues a run.
+			s.runTriggerPatterns = []string{"**/*"}
+		} else {
+			for _, prefix := range attributes.TriggerPrefixes {
+				s.runTriggerPatterns = append(s.runTriggerPatterns, strings.Trim(prefix, "/")+"/**/*")
+			}
+			for _, pattern := range attributes.TriggerPatterns {
+				s.runTriggerPatterns = append(s.runTriggerPatterns, strings.TrimPrefix(pattern, "/"))
+			}
+		}
+
+		retVal = append(retVal, s)
+		byWorkspaceId[r.ID] = s
+	}
+
+	for _, r := range resources {
+		if seen[r.ID] {
+			continue
+		}
+
+		switch r.Type {
+		case tfcVarsType:
+			seen[r.ID] = true
+
+			var attributes tfcVarAttributes
+			if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+				diags.AddError(validationError, fmt.Sprintf("Failed to parse variable %s: %s", r.ID, err))
+				return nil, diags
+			}
+
+			s := byWorkspaceId[r.relationship("configurable")]
+			if s == nil {
+				diags.AddWarning("Variable not migrated", fmt.Sprintf("Variable '%s' does not belong to a migrated workspace", attributes.Key))
+				continue
+			}
+
+			var variableType string
+			switch attributes.Category {
+			case tfcTerraformCategory:
+				variableType = cmTypes.TfTVar
+			case tfcEnvCategory:
+				variableType = cmTypes.EnvVar
+			default:
+				diags.AddWarning("Variable not migrated", fmt.Sprintf("Variable '%s' of workspace '%s' has unsupported category '%s'", attributes.Key, s.name, attributes.Category))
+				continue
+			}
+
+			s.variables = append(s.variables, &migratedVariable{
+				key:          attributes.Key,
+				value:        attributes.Value,
+				variableType: variableType,
+				isSensitive:  attributes.Sensitive,
+				description:  attributes.Description,
+			})
+		case tfcRunTriggersType:
+			seen[r.ID] = true
+
+			// A run trigger queues a run of the workspace after a run of the sourceable workspace is applied.
+			s := byWorkspaceId[r.relationship("workspace")]
+			if s == nil {
+				diags.AddWarning("Run trigger not migrated", fmt.Sprintf("Run trigger %s does not trigger a migrated workspace", r.ID))
+				continue
+			}
+
+			s.dependsOnStackIds = append(s.dependsOnStackIds, r.relationship("sourceable"))
+		}
+	}
+
+	return retVal, diags
+}
+
+//endregion
@@ -1,6 +1,9 @@
 package helpers
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+)
 
 func Filter[T any](ss []T, filter func(T) bool) (retVal []T) {
 	for _, s := range ss {
@@ -42,6 +45,17 @@ func AnyMatch[T any](ss []T, filter func(T) bool) bool {
 	return false
 }
 
+// SortedKeys returns the keys of the map in ascending order.
+func SortedKeys[T any](m map[string]T) []string {
+	retVal := make([]string, 0, len(m))
+	for k := range m {
+		retVal = append(retVal, k)
+	}
+	sort.Strings(retVal)
+
+	return retVal
+}
+
 func IsUnique[T int | string](es []T) bool {
 	alreadyAppeared := make(map[T]bool)
 
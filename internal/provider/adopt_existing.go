@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const adoptExistingAttributeName = "adopt_existing"
+
+// adoptExistingAttribute returns the per resource override of the provider level `adopt_existing`.
+func adoptExistingAttribute(entity string, lookup string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("When enabled, creating this resource adopts the existing %s %s instead of failing, and applies the configuration to it. Overrides the provider level `adopt_existing`.", entity, lookup),
+		Optional:            true,
+	}
+}
+
+// shouldAdoptExisting resolves the per resource `adopt_existing` value against the provider level default.
+func (c *ControlMonkeyAPIClient) shouldAdoptExisting(v types.Bool) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return c.AdoptExisting
+	}
+
+	return v.ValueBool()
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+)
+
+type testExistingEntity struct {
+	id   string
+	name string
+}
+
+func TestAdoptExisting(t *testing.T) {
+	listErr := errors.New("list failed")
+	updateErr := errors.New("update failed")
+
+	cases := map[string]struct {
+		listed          []testExistingEntity
+		listErr         error
+		updateErr       error
+		expectedAdopted bool
+		expectedId      string
+		expectedUpdates []string
+		expectedError   string
+	}{
+		"no entity": {
+			listed: []testExistingEntity{},
+		},
+		"partial name matches only": {
+			listed: []testExistingEntity{{id: "e-1", name: "team-a-old"}, {id: "e-2", name: "old-team-a"}},
+		},
+		"single match": {
+			listed:          []testExistingEntity{{id: "e-1", name: "team-a-old"}, {id: "e-2", name: "team-a"}},
+			expectedAdopted: true,
+			expectedId:      "e-2",
+			expectedUpdates: []string{"e-2"},
+		},
+		"multiple matches": {
+			listed:        []testExistingEntity{{id: "e-1", name: "team-a"}, {id: "e-2", name: "team-a"}},
+			expectedError: "Found multiple entities of type team with name 'team-a'",
+		},
+		"list fails": {
+			listErr:       listErr,
+			expectedError: "failed to look up an existing team to adopt",
+		},
+		"update fails": {
+			listed:          []testExistingEntity{{id: "e-1", name: "team-a"}},
+			updateErr:       updateErr,
+			expectedUpdates: []string{"e-1"},
+			expectedError:   "failed to update adopted team e-1",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updates []string
+
+			id, adopted, diags := commons.AdoptExisting(context.Background(), "team-a", commons.ExistingEntity[testExistingEntity]{
+				Kind: "team",
+				List: func(_ context.Context) ([]testExistingEntity, error) {
+					return c.listed, c.listErr
+				},
+				Match: func(e testExistingEntity) bool { return e.name == "team-a" },
+				ID:    func(e testExistingEntity) string { return e.id },
+				Read: func(_ context.Context, id string) (testExistingEntity, error) {
+					for _, e := range c.listed {
+						if e.id == id {
+							return e, nil
+						}
+					}
+					t.Fatalf("unexpected read of %s", id)
+					return testExistingEntity{}, nil
+				},
+				Update: func(_ context.Context, id string, existing testExistingEntity) error {
+					if existing.id != id {
+						t.Errorf("expected the existing entity %s to be updated, got %s", id, existing.id)
+					}
+					updates = append(updates, id)
+					return c.updateErr
+				},
+			})
+
+			if adopted != c.expectedAdopted || id != c.expectedId {
+				t.Errorf("expected adopted %t with ID '%s', got %t with '%s'", c.expectedAdopted, c.expectedId, adopted, id)
+			}
+			if strings.Join(updates, ",") != strings.Join(c.expectedUpdates, ",") {
+				t.Errorf("expected updates of %v, got %v", c.expectedUpdates, updates)
+			}
+
+			if c.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+			} else if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), c.expectedError) {
+				t.Errorf("expected an error containing '%s', got %v", c.expectedError, diags)
+			}
+		})
+	}
+}
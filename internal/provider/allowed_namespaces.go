@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const namespaceNotAllowedError = "Namespace not allowed"
+
+// hasNamespaceRestrictions reports whether the provider limits the namespaces resources may be managed in.
+func (c *ControlMonkeyAPIClient) hasNamespaceRestrictions() bool {
+	return c != nil && (len(c.AllowedNamespaceIds) > 0 || len(c.AllowedNamespaceNames) > 0)
+}
+
+// namespaceNotAllowedDiagnostics returns an error when the namespace is not one of the allowed_namespace_ids or
+// allowed_namespace_names of the provider. Unknown namespace IDs are skipped, as they are only known after apply.
+func (c *ControlMonkeyAPIClient) namespaceNotAllowedDiagnostics(ctx context.Context, attributePath path.Path, namespaceId types.String) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if !c.hasNamespaceRestrictions() || !helpers.IsKnown(namespaceId) {
+		return retVal
+	}
+
+	id := namespaceId.ValueString()
+	if slices.Contains(c.AllowedNamespaceIds, id) {
+		return retVal
+	}
+
+	if len(c.AllowedNamespaceNames) > 0 {
+		res, err := c.Client.namespace.ReadNamespace(ctx, id)
+		if err != nil {
+			retVal.Append(commons.ApiErrorDiagnostics(ctx, namespaceNotAllowedError, fmt.Sprintf("failed to read namespace %s to check it against allowed_namespace_names", id), err)...)
+			return retVal
+		}
+
+		if slices.Contains(c.AllowedNamespaceNames, controlmonkey.StringValue(res.Name)) {
+			return retVal
+		}
+	}
+
+	retVal.AddAttributeError(
+		attributePath,
+		namespaceNotAllowedError,
+		fmt.Sprintf("Namespace '%s' is not in allowed_namespace_ids or allowed_namespace_names of the provider configuration.", id),
+	)
+
+	return retVal
+}
+
+// stackNamespaceNotAllowedDiagnostics is like namespaceNotAllowedDiagnostics, for the namespace of the given stack.
+func (c *ControlMonkeyAPIClient) stackNamespaceNotAllowedDiagnostics(ctx context.Context, attributePath path.Path, stackId types.String) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if !c.hasNamespaceRestrictions() || !helpers.IsKnown(stackId) {
+		return retVal
+	}
+
+	id := stackId.ValueString()
+	res, err := c.Client.stack.ReadStack(ctx, id)
+	if err != nil {
+		retVal.Append(commons.ApiErrorDiagnostics(ctx, namespaceNotAllowedError, fmt.Sprintf("failed to read stack %s to check its namespace", id), err)...)
+		return retVal
+	}
+
+	return c.namespaceNotAllowedDiagnostics(ctx, attributePath, helpers.StringValueOrNull(res.NamespaceId))
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNamespaceNotAllowedDiagnostics(t *testing.T) {
+	ctx := context.Background()
+	client := &ControlMonkeyAPIClient{AllowedNamespaceIds: []string{"ns-allowed"}}
+
+	if diags := client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), types.StringValue("ns-allowed")); diags.HasError() {
+		t.Errorf("expected allowed namespace to pass, got %v", diags)
+	}
+
+	if diags := client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), types.StringValue("ns-other")); !diags.HasError() {
+		t.Error("expected namespace which is not allowed to fail")
+	}
+
+	if diags := client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), types.StringUnknown()); diags.HasError() {
+		t.Errorf("expected unknown namespace to be skipped, got %v", diags)
+	}
+
+	unrestricted := &ControlMonkeyAPIClient{}
+	if diags := unrestricted.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), types.StringValue("ns-other")); diags.HasError() {
+		t.Errorf("expected no restrictions without allowed namespaces, got %v", diags)
+	}
+}
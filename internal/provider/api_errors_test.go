@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func testSdkError(statusCode int, code string, field string) client.Error {
+	return client.Error{
+		Response: &http.Response{
+			StatusCode: statusCode,
+			Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/stack"}},
+		},
+		Code:      code,
+		Message:   "message of " + code,
+		Field:     field,
+		RequestID: "req-123",
+	}
+}
+
+func TestAsApiError(t *testing.T) {
+	tests := []struct {
+		name               string
+		err                error
+		expectedOk         bool
+		expectedStatusCode int
+		expectedDetails    int
+	}{
+		{"single error", testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "name"), true, http.StatusBadRequest, 1},
+		{"multiple errors", client.Errors{testSdkError(http.StatusBadRequest, "a", ""), testSdkError(http.StatusBadRequest, "b", "")}, true, http.StatusBadRequest, 2},
+		{"wrapped errors", fmt.Errorf("create: %w", client.Errors{testSdkError(http.StatusConflict, "conflict", "")}), true, http.StatusConflict, 1},
+		{"empty errors", client.Errors{}, false, 0, 0},
+		{"network error", errors.New("dial tcp: connection refused"), false, 0, 0},
+		{"cancelled context", context.Canceled, false, 0, 0},
+		{"error text with a code", errors.New("not_found"), false, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiErr, ok := commons.AsApiError(test.err)
+			if ok != test.expectedOk {
+				t.Fatalf("expected ok %v, got %v", test.expectedOk, ok)
+			}
+			if !ok {
+				return
+			}
+
+			if apiErr.StatusCode != test.expectedStatusCode {
+				t.Errorf("expected status code %d, got %d", test.expectedStatusCode, apiErr.StatusCode)
+			}
+			if len(apiErr.Details) != test.expectedDetails {
+				t.Errorf("expected %d details, got %d", test.expectedDetails, len(apiErr.Details))
+			}
+			if apiErr.RequestID != "req-123" || apiErr.Method != http.MethodPost || apiErr.Path != "/stack" {
+				t.Errorf("expected the request of the response, got %s %s (request ID: %s)", apiErr.Method, apiErr.Path, apiErr.RequestID)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundResponseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"not found code", client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeNotFound, "")}, true},
+		{"404 without a code", client.Errors{testSdkError(http.StatusNotFound, "404", "")}, true},
+		{"404 with another code", client.Errors{testSdkError(http.StatusNotFound, "no_such_entity", "")}, true},
+		{"already exist", client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeAlreadyExist, "")}, false},
+		{"forbidden", client.Errors{testSdkError(http.StatusForbidden, "403", "")}, false},
+		{"error text with the code", errors.New("failed: not_found"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := commons.IsNotFoundResponseError(test.err); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsAlreadyExistResponseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"already exist code", client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeAlreadyExist, "name")}, true},
+		{"already exist among other codes", client.Errors{testSdkError(http.StatusBadRequest, "a", ""), testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeAlreadyExist, "")}, true},
+		{"conflict without a code", client.Errors{testSdkError(http.StatusConflict, "409", "")}, false},
+		{"not found", client.Errors{testSdkError(http.StatusNotFound, cmTypes.ErrorCodeNotFound, "")}, false},
+		{"error text with the code", errors.New("failed: already_exist"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := commons.IsAlreadyExistResponseError(test.err); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestApiErrorDiagnosticsForPlan(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewStackResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+	tests := []struct {
+		name                   string
+		err                    error
+		expectedAttributePaths []path.Path
+		expectedErrorContains  []string
+	}{
+		{
+			name:                   "field of a nested attribute",
+			err:                    client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "vcsInfo.repoName")},
+			expectedAttributePaths: []path.Path{path.Root("vcs_info").AtName("repo_name")},
+		},
+		{
+			name:                   "field of a list element",
+			err:                    client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "deploymentApprovalPolicy.rules[0].type")},
+			expectedAttributePaths: []path.Path{path.Root("deployment_approval_policy").AtName("rules").AtListIndex(0).AtName("type")},
+		},
+		{
+			name:                   "element of a list attribute",
+			err:                    client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "runTrigger.patterns[1]")},
+			expectedAttributePaths: []path.Path{path.Root("run_trigger").AtName("patterns").AtListIndex(1)},
+		},
+		{
+			name:                  "field that is not in the schema",
+			err:                   client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "vcsInfo.repoId")},
+			expectedErrorContains: []string{"validation_error: message of validation_error (field: vcsInfo.repoId)"},
+		},
+		{
+			name:                  "field that does not map to a path",
+			err:                   client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "vcs-info/repo")},
+			expectedErrorContains: []string{"(field: vcs-info/repo)"},
+		},
+		{
+			name: "field and general errors",
+			err: client.Errors{
+				testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "name"),
+				testSdkError(http.StatusBadRequest, "quota_exceeded", ""),
+			},
+			expectedAttributePaths: []path.Path{path.Root("name")},
+			expectedErrorContains:  []string{"quota_exceeded: message of quota_exceeded", "Request ID: req-123"},
+		},
+		{
+			name:                  "not an API error",
+			err:                   errors.New("connection refused"),
+			expectedErrorContains: []string{"connection refused"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := commons.ApiErrorDiagnosticsForPlan(ctx, plan, "Stack creation failed", "failed to create stack", test.err)
+
+			var attributePaths []path.Path
+			var generalErrors []diag.Diagnostic
+			for _, d := range diags {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attributePaths = append(attributePaths, withPath.Path())
+					if !strings.Contains(d.Detail(), "Request ID: req-123") {
+						t.Errorf("expected the request ID in the attribute error, got %s", d.Detail())
+					}
+					if !strings.Contains(d.Detail(), "validation_error: message of validation_error") {
+						t.Errorf("expected the error code in the attribute error, got %s", d.Detail())
+					}
+				} else {
+					generalErrors = append(generalErrors, d)
+				}
+			}
+
+			if len(attributePaths) != len(test.expectedAttributePaths) {
+				t.Fatalf("expected attribute errors at %v, got %v", test.expectedAttributePaths, attributePaths)
+			}
+			for i, p := range test.expectedAttributePaths {
+				if !attributePaths[i].Equal(p) {
+					t.Errorf("expected an attribute error at %s, got %s", p, attributePaths[i])
+				}
+			}
+
+			if len(test.expectedErrorContains) == 0 {
+				if len(generalErrors) != 0 {
+					t.Errorf("expected no general error, got %v", generalErrors)
+				}
+				return
+			}
+
+			if len(generalErrors) != 1 {
+				t.Fatalf("expected a single general error, got %v", generalErrors)
+			}
+			for _, text := range test.expectedErrorContains {
+				if !strings.Contains(generalErrors[0].Detail(), text) {
+					t.Errorf("expected the general error to contain '%s', got %s", text, generalErrors[0].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestApiErrorDiagnosticsWithoutPlan(t *testing.T) {
+	err := client.Errors{testSdkError(http.StatusBadRequest, cmTypes.ErrorCodeValidationError, "name")}
+	diags := commons.ApiErrorDiagnostics(context.Background(), "Failed to read stack", "", err)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %v", diags)
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected a general error, got an attribute error")
+	}
+	if !strings.Contains(diags[0].Detail(), "validation_error: message of validation_error (field: name)") {
+		t.Errorf("expected the field in the error, got %s", diags[0].Detail())
+	}
+}
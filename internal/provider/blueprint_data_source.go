@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfBlueprint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -69,6 +70,11 @@ func (r *BlueprintDataSource) Configure(_ context.Context, req datasource.Config
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Get current state
 	var state tfBlueprint.ResourceModel
 
@@ -82,7 +88,7 @@ func (r *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 	res, err := r.client.Client.blueprint.ListBlueprints(ctx, blueprintId, name)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read blueprint"), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read blueprint"), "", err)...)
 		return
 	} else if len(res) == 0 {
 		resp.Diagnostics.AddError(fmt.Sprintf(resourceNotFoundError), fmt.Sprintf(blueprintNotFoundError))
@@ -26,6 +26,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &BlueprintNamespaceMappingsResource{}
+var _ resource.ResourceWithModifyPlan = &BlueprintNamespaceMappingsResource{}
 
 func NewBlueprintNamespaceMappingsResource() resource.Resource {
 	return &BlueprintNamespaceMappingsResource{}
@@ -39,7 +40,7 @@ func (r *BlueprintNamespaceMappingsResource) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_blueprint_namespace_mappings"
 }
 
-func (r *BlueprintNamespaceMappingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BlueprintNamespaceMappingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys mappings from blueprint to namespaces.",
 		Attributes: map[string]schema.Attribute{
@@ -91,6 +92,9 @@ func (r *BlueprintNamespaceMappingsResource) Schema(_ context.Context, _ resourc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -134,8 +138,28 @@ func (r *BlueprintNamespaceMappingsResource) ValidateConfig(ctx context.Context,
 	}
 }
 
+// ModifyPlan fails when the blueprint namespace mapping is planned in a namespace that is not allowed by the provider configuration.
+func (r *BlueprintNamespaceMappingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.client.hasNamespaceRestrictions() {
+		return
+	}
+
+	var plan blueprintNamespaces.ResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	for _, namespace := range plan.Namespaces {
+		resp.Diagnostics.Append(r.client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespaces"), namespace.NamespaceId)...)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *BlueprintNamespaceMappingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state blueprintNamespaces.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -144,6 +168,12 @@ func (r *BlueprintNamespaceMappingsResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.blueprint.ListBlueprintNamespaceMappings(ctx, id)
 	if err != nil {
@@ -153,7 +183,7 @@ func (r *BlueprintNamespaceMappingsResource) Read(ctx context.Context, req resou
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read blueprint-namespace mappings for blueprint '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read blueprint-namespace mappings for blueprint '%s'", id), "", err)...)
 		return
 	}
 
@@ -169,6 +199,12 @@ func (r *BlueprintNamespaceMappingsResource) Read(ctx context.Context, req resou
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BlueprintNamespaceMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan blueprintNamespaces.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -177,6 +213,12 @@ func (r *BlueprintNamespaceMappingsResource) Create(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := blueprintNamespaces.Merge(&plan, nil, commons.CreateMerger)
 	blueprintId := plan.BlueprintId
 
@@ -197,6 +239,12 @@ func (r *BlueprintNamespaceMappingsResource) Create(ctx context.Context, req res
 }
 
 func (r *BlueprintNamespaceMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan blueprintNamespaces.ResourceModel
 	var state blueprintNamespaces.ResourceModel
@@ -210,6 +258,12 @@ func (r *BlueprintNamespaceMappingsResource) Update(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := blueprintNamespaces.Merge(&plan, &state, commons.UpdateMerger)
 
 	diags = r.createEntities(ctx, mergeResult.EntitiesToCreate, plan.BlueprintId.ValueString())
@@ -233,6 +287,12 @@ func (r *BlueprintNamespaceMappingsResource) Update(ctx context.Context, req res
 }
 
 func (r *BlueprintNamespaceMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state blueprintNamespaces.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -241,6 +301,12 @@ func (r *BlueprintNamespaceMappingsResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := blueprintNamespaces.Merge(nil, &state, commons.DeleteMerger)
 
 	diags = r.deleteEntities(ctx, mergeResult.EntitiesToDelete, state.BlueprintId.ValueString())
@@ -266,14 +332,9 @@ func (r *BlueprintNamespaceMappingsResource) createEntities(ctx context.Context,
 			if commons.IsAlreadyExistResponseError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Namespace '%s' is already mapped to blueprint '%s'. No operation was made.", namespaceId, blueprintId))
 			} else if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to map namespace '%s' to blueprint '%s'. Error: %s",
-						namespaceId, blueprintId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to map namespace '%s' to blueprint '%s'", namespaceId, blueprintId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to map namespace '%s' to blueprint '%s'", namespaceId, blueprintId), err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to map namespace '%s' to blueprint '%s'", namespaceId, blueprintId), "", err)
 			}
 		}
 	}
@@ -292,14 +353,9 @@ func (r *BlueprintNamespaceMappingsResource) deleteEntities(ctx context.Context,
 		if err != nil {
 			namespaceId := *e.NamespaceId
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to delete mapping between namespace '%s' and blueprint '%s'. Error: %s", namespaceId, blueprintId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to delete mapping between namespace '%s' and blueprint '%s'", namespaceId, blueprintId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to delete mapping between namespace '%s' and blueprint '%s'", namespaceId, blueprintId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to delete mapping between namespace '%s' and blueprint '%s'", namespaceId, blueprintId), "", err)
 			}
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -18,7 +19,7 @@ const (
 func testAccBlueprintNamespaceMappingsResourceSetup(providerId string, repoName string) string {
 	return fmt.Sprintf(`
 resource "cm_blueprint" "test_blueprint" {
-    name = "Variable Test Blueprint"
+    name = "`+test_helpers.NamePrefix+`Variable Test Blueprint"
     description = "Blueprint for testing variables"
 
     blueprint_vcs_info = {
@@ -51,15 +52,15 @@ resource "cm_blueprint" "test_blueprint" {
 }
 
 resource "cm_namespace" "test_mapping_namespace" {
-  name = "TestMappingNamespace"
+  name = "`+test_helpers.NamePrefix+`TestMappingNamespace"
 }
 
 resource "cm_namespace" "namespace"{
-  name = "Namespace Resource"
+  name = "`+test_helpers.NamePrefix+`Namespace Resource"
 }
 
 resource "cm_namespace" "namespace2" {
-  name = "Namespace Resource2"
+  name = "`+test_helpers.NamePrefix+`Namespace Resource2"
 }
 `, providerId, repoName, providerId, repoName)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	sdkBlueprint "github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
@@ -12,6 +13,7 @@ import (
 	tfBlueprint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint"
 	cm_stringvalidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +25,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &BlueprintResource{}
+var _ resource.ResourceWithModifyPlan = &BlueprintResource{}
 
 func NewBlueprintResource() resource.Resource {
 	return &BlueprintResource{}
@@ -36,7 +39,7 @@ func (r *BlueprintResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_blueprint"
 }
 
-func (r *BlueprintResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BlueprintResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys blueprints. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/self-service-templates/persistent-template)",
 		Attributes: map[string]schema.Attribute{
@@ -204,6 +207,11 @@ func (r *BlueprintResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			deletionProtectionAttributeName: deletionProtectionAttribute("blueprint"),
+			adoptExistingAttributeName:      adoptExistingAttribute("blueprint", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -229,8 +237,17 @@ func (r *BlueprintResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ModifyPlan fails when a blueprint with deletion protection would be destroyed or replaced.
+func (r *BlueprintResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(deletionProtectionPlanDiagnostics(ctx, "blueprint", req)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state tfBlueprint.ResourceModel
 
@@ -241,6 +258,12 @@ func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.blueprint.ReadBlueprint(ctx, id)
 
@@ -251,7 +274,7 @@ func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read blueprint '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read blueprint '%s'", id), "", err)...)
 		return
 	}
 
@@ -267,6 +290,12 @@ func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfBlueprint.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -275,14 +304,31 @@ func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := tfBlueprint.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.blueprint.CreateBlueprint(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create blueprint, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create blueprint", err)...)
 		return
 	}
 
@@ -297,6 +343,12 @@ func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfBlueprint.ResourceModel
 	var state tfBlueprint.ResourceModel
@@ -310,6 +362,12 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfBlueprint.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -320,10 +378,7 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update blueprint %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update blueprint %s", id), err)...)
 		return
 	}
 
@@ -336,6 +391,12 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfBlueprint.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -344,7 +405,18 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
+
+	resp.Diagnostics.Append(deletionProtectionDeleteDiagnostics("blueprint", id, state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	_, err := r.client.Client.blueprint.DeleteBlueprint(ctx, id)
 
 	if err != nil {
@@ -353,10 +425,7 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete blueprint %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete blueprint %s", id), err)...)
 		return
 	}
 }
@@ -364,3 +433,40 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *BlueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing blueprint with the planned name and applies the plan to it. It returns
+// false when there is no such blueprint.
+func (r *BlueprintResource) adoptExisting(ctx context.Context, plan *tfBlueprint.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkBlueprint.Blueprint]{
+		Kind: "blueprint",
+		List: func(ctx context.Context) ([]*sdkBlueprint.Blueprint, error) {
+			return r.client.Client.blueprint.ListBlueprints(ctx, nil, plan.Name.ValueStringPointer())
+		},
+		Match: func(e *sdkBlueprint.Blueprint) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkBlueprint.Blueprint) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.blueprint.ReadBlueprint,
+		Update: func(ctx context.Context, id string, existing *sdkBlueprint.Blueprint) error {
+			var state tfBlueprint.ResourceModel
+			tfBlueprint.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := tfBlueprint.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.blueprint.UpdateBlueprint(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
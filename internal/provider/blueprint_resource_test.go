@@ -16,7 +16,7 @@ const (
 	tfBlueprintResourceResource = "cm_blueprint"
 	blueprintTfResourceName     = "blueprint"
 
-	blueprintName                          = "Test Blueprint"
+	blueprintName                          = test_helpers.NamePrefix + "Test Blueprint"
 	blueprintDescription                   = "Description"
 	blueprintRepoPath                      = "cm/blueprint"
 	blueprintStackConfigurationIacType     = "terraform"
@@ -28,7 +28,7 @@ const (
 
 	blueprintOpenCleanupPrOnTtlTerminationAfterUpdate = "false"
 	blueprintPolicyMaxTtlValueAfterUpdate             = "4"
-	blueprintNameAfterUpdate                          = "updated name"
+	blueprintNameAfterUpdate                          = test_helpers.NamePrefix + "updated name"
 )
 
 func TestAccBlueprintResource(t *testing.T) {
@@ -206,11 +206,11 @@ resource "%s" "%s" {
 				},
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-	name = "Developers"
+	name = "`+test_helpers.NamePrefix+`Developers"
 }
 
 resource "cm_team" "team2" {
-	name = "QA"
+	name = "`+test_helpers.NamePrefix+`QA"
 }
 
 variable "lte_value" {
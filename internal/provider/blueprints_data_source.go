@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	sdkBlueprint "github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	tfBlueprint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint_data"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BlueprintsDataSource{}
+
+func NewBlueprintsDataSource() datasource.DataSource {
+	return &BlueprintsDataSource{}
+}
+
+type BlueprintsDataSource struct {
+	client *ControlMonkeyAPIClient
+}
+
+func (r *BlueprintsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints"
+}
+
+func (r *BlueprintsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nameFilterAttributes("blueprints")
+	attributes["blueprints"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The blueprints matching the filters.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: resourceComputedAttributes(ctx, NewBlueprintResource(), &resp.Diagnostics),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists blueprints, optionally filtered by name.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *BlueprintsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ControlMonkeyAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ControlMonkeyAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *BlueprintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//Get current state
+	var state tfBlueprint.ListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newNameFilter(state.NamePrefix, state.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Client.blueprint.ListBlueprints(ctx, nil, nil)
+	if err != nil {
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to list blueprints", "", err)...)
+		return
+	}
+
+	res = helpers.Filter(res, func(e *sdkBlueprint.Blueprint) bool { return filter.matches(e.Name) })
+
+	tfBlueprint.UpdateStateAfterList(res, &state)
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlueprintsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "cm_blueprints" "blueprints" {
+  name_regex = "^Blueprint Unique$"
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_blueprints.blueprints", "blueprints.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_blueprints.blueprints", "blueprints.0.id"),
+					resource.TestCheckResourceAttr("data.cm_blueprints.blueprints", "blueprints.0.name", "Blueprint Unique"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package commons
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	adoptCreationFailedError = "Resource creation failed"
+	adoptUpdateFailedError   = "Resource update failed"
+	adoptMultipleError       = "Found multiple entities"
+)
+
+// ExistingEntity is how a resource finds an existing entity with the planned name and takes ownership of it.
+type ExistingEntity[E any] struct {
+	// Kind names the entity in diagnostics, e.g. "control policy group".
+	Kind string
+	// List returns the candidates for the planned name, the API may match names partially.
+	List func(ctx context.Context) ([]E, error)
+	// Match reports whether the candidate is the entity to adopt.
+	Match func(entity E) bool
+	ID    func(entity E) string
+	Read  func(ctx context.Context, id string) (E, error)
+	// Update applies the plan to the existing entity, it sends no request when the entity already matches the plan.
+	Update func(ctx context.Context, id string, existing E) error
+}
+
+// AdoptExisting takes ownership of the single existing entity matching the plan and applies the plan to it. It returns
+// the ID of the adopted entity, or false when there is no such entity.
+func AdoptExisting[E any](ctx context.Context, name string, e ExistingEntity[E]) (string, bool, diag.Diagnostics) {
+	var retVal diag.Diagnostics
+
+	res, err := e.List(ctx)
+	if err != nil {
+		retVal.Append(ApiErrorDiagnostics(ctx, adoptCreationFailedError, fmt.Sprintf("failed to look up an existing %s to adopt", e.Kind), err)...)
+		return "", false, retVal
+	}
+
+	res = helpers.Filter(res, e.Match)
+	if len(res) == 0 {
+		return "", false, retVal
+	} else if len(res) > 1 {
+		retVal.AddError(adoptMultipleError, fmt.Sprintf("Found multiple entities of type %s with name '%s', cannot decide which one to adopt", e.Kind, name))
+		return "", false, retVal
+	}
+
+	id := e.ID(res[0])
+	existing, err := e.Read(ctx, id)
+	if err != nil {
+		retVal.Append(ApiErrorDiagnostics(ctx, adoptCreationFailedError, fmt.Sprintf("failed to read %s %s to adopt", e.Kind, id), err)...)
+		return "", false, retVal
+	}
+
+	if err := e.Update(ctx, id, existing); err != nil {
+		retVal.Append(ApiErrorDiagnostics(ctx, adoptUpdateFailedError, fmt.Sprintf("failed to update adopted %s %s", e.Kind, id), err)...)
+		return "", false, retVal
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Adopted existing %s", e.Kind), map[string]interface{}{"id": id})
+
+	return id, true, retVal
+}
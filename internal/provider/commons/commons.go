@@ -1,11 +1,5 @@
 package commons
 
-import (
-	"strings"
-
-	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
-)
-
 type ConverterType string
 
 const (
@@ -16,18 +10,3 @@ const (
 	UpdateMerger ConverterType = "updateMerger"
 	DeleteMerger ConverterType = "deleteMerger"
 )
-
-func IsNotFoundResponseError(err error) bool {
-	retVal := strings.Contains(err.Error(), commons.ErrorCodeNotFound)
-	return retVal
-}
-
-func IsAlreadyExistResponseError(err error) bool {
-	retVal := strings.Contains(err.Error(), commons.ErrorCodeAlreadyExist)
-	return retVal
-}
-
-func DoesErrorContains(err error, s string) bool {
-	retVal := strings.Contains(err.Error(), s)
-	return retVal
-}
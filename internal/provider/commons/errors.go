@@ -0,0 +1,324 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ApiError is a typed view of an error response returned by the ControlMonkey API.
+type ApiError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	RequestID  string
+	Details    []ApiErrorDetail
+}
+
+// ApiErrorDetail is a single error reported by the API. Field is set when the API names the offending field.
+type ApiErrorDetail struct {
+	Code    string
+	Message string
+	Field   string
+}
+
+// AsApiError extracts the API error from err. It returns false for errors that did not come from an API response,
+// e.g. network failures or cancelled contexts.
+func AsApiError(err error) (*ApiError, bool) {
+	var sdkErrors client.Errors
+	var sdkError client.Error
+
+	if errors.As(err, &sdkErrors) && len(sdkErrors) > 0 {
+		return apiErrorFromSdk(sdkErrors), true
+	} else if errors.As(err, &sdkError) {
+		return apiErrorFromSdk(client.Errors{sdkError}), true
+	}
+
+	return nil, false
+}
+
+func (e *ApiError) Error() string {
+	messages := helpers.Map(e.Details, func(d ApiErrorDetail) string {
+		retVal := fmt.Sprintf("%s: %s", d.Code, d.Message)
+		if d.Field != "" {
+			retVal = fmt.Sprintf("%s (field: %s)", retVal, d.Field)
+		}
+
+		return retVal
+	})
+
+	return fmt.Sprintf("%s %s: %d (request ID: %s) %s", e.Method, e.Path, e.StatusCode, e.RequestID, strings.Join(messages, "; "))
+}
+
+func (e *ApiError) HasCode(code string) bool {
+	for _, d := range e.Details {
+		if d.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNotFound reports whether the entity does not exist. The API does not set an error code on every 404 response.
+func (e *ApiError) IsNotFound() bool {
+	return e.HasCode(commons.ErrorCodeNotFound) || e.StatusCode == http.StatusNotFound
+}
+
+func (e *ApiError) IsAlreadyExist() bool {
+	return e.HasCode(commons.ErrorCodeAlreadyExist)
+}
+
+func (e *ApiError) IsValidationError() bool {
+	return e.HasCode(commons.ErrorCodeValidationError) || e.StatusCode == http.StatusUnprocessableEntity
+}
+
+func (e *ApiError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *ApiError) IsForbidden() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+func (e *ApiError) IsConflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+func (e *ApiError) IsTooManyRequests() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+func IsNotFoundResponseError(err error) bool {
+	apiErr, ok := AsApiError(err)
+	return ok && apiErr.IsNotFound()
+}
+
+func IsAlreadyExistResponseError(err error) bool {
+	apiErr, ok := AsApiError(err)
+	return ok && apiErr.IsAlreadyExist()
+}
+
+// ApiErrorDiagnostics converts err into an error diagnostic. detail describes the failed operation, the reason reported
+// by the API is appended to it.
+func ApiErrorDiagnostics(ctx context.Context, summary string, detail string, err error) diag.Diagnostics {
+	return apiErrorDiagnostics(ctx, nil, summary, detail, err)
+}
+
+// ApiErrorDiagnosticsForPlan is ApiErrorDiagnostics for requests built from the plan. An error of a field that maps to
+// an attribute of the schema of the plan is reported on that attribute only, the other errors are reported together
+// in a single error diagnostic.
+func ApiErrorDiagnosticsForPlan(ctx context.Context, plan tfsdk.Plan, summary string, detail string, err error) diag.Diagnostics {
+	return apiErrorDiagnostics(ctx, func(p path.Path) bool { return schemaHasAttribute(ctx, plan, p) }, summary, detail, err)
+}
+
+//region Private
+
+func apiErrorDiagnostics(ctx context.Context, hasAttribute func(path.Path) bool, summary string, detail string, err error) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	apiErr, ok := AsApiError(err)
+	if !ok {
+		retVal.AddError(summary, withReason(detail, err.Error()))
+		return retVal
+	}
+
+	tflog.Error(ctx, "ControlMonkey API request failed", apiErr.logFields())
+
+	var generalDetails []ApiErrorDetail
+	for _, d := range apiErr.Details {
+		if p, ok := attributePathFromApiField(d.Field); ok && hasAttribute != nil && hasAttribute(p) {
+			retVal.AddAttributeError(p, summary, fmt.Sprintf("%s\n\n%s", withReason(detail, d.reason()), apiErr.requestInfo()))
+		} else {
+			generalDetails = append(generalDetails, d)
+		}
+	}
+
+	if len(generalDetails) > 0 || len(apiErr.Details) == 0 {
+		reason := detailsReason(generalDetails)
+		if hint := apiErr.hint(); hint != "" {
+			reason = fmt.Sprintf("%s. %s", reason, hint)
+		}
+
+		retVal.AddError(summary, fmt.Sprintf("%s\n\n%s", withReason(detail, reason), apiErr.requestInfo()))
+	}
+
+	return retVal
+}
+
+// schemaHasAttribute reports whether p is an attribute of the schema of the plan, or an element of one.
+func schemaHasAttribute(ctx context.Context, plan tfsdk.Plan, p path.Path) bool {
+	if plan.Schema == nil {
+		return false
+	}
+
+	for {
+		lastStep, _ := p.Steps().LastStep()
+		if _, ok := lastStep.(path.PathStepElementKeyInt); !ok {
+			break
+		}
+		p = p.ParentPath()
+	}
+
+	_, diags := plan.Schema.AttributeAtPath(ctx, p)
+	return !diags.HasError()
+}
+
+func apiErrorFromSdk(sdkErrors client.Errors) *ApiError {
+	retVal := &ApiError{
+		RequestID: sdkErrors[0].RequestID,
+	}
+
+	if resp := sdkErrors[0].Response; resp != nil {
+		retVal.StatusCode = resp.StatusCode
+
+		if req := resp.Request; req != nil {
+			retVal.Method = req.Method
+			if req.URL != nil {
+				retVal.Path = req.URL.Path
+			}
+		}
+	}
+
+	for _, e := range sdkErrors {
+		retVal.Details = append(retVal.Details, ApiErrorDetail{Code: e.Code, Message: e.Message, Field: e.Field})
+	}
+
+	return retVal
+}
+
+func detailsReason(details []ApiErrorDetail) string {
+	messages := helpers.Map(details, func(d ApiErrorDetail) string {
+		if d.Field != "" {
+			return fmt.Sprintf("%s (field: %s)", d.reason(), d.Field)
+		}
+
+		return d.reason()
+	})
+
+	return strings.Join(messages, "; ")
+}
+
+// reason is the message of the error prefixed with its code, e.g. "validation_error: name is required".
+func (d ApiErrorDetail) reason() string {
+	if d.Code == "" {
+		return d.Message
+	}
+
+	return fmt.Sprintf("%s: %s", d.Code, d.Message)
+}
+
+// requestInfo describes the failed request, so it can be correlated with the ControlMonkey support team.
+func (e *ApiError) requestInfo() string {
+	return fmt.Sprintf("Request: %s %s\nStatus code: %d\nRequest ID: %s", e.Method, e.Path, e.StatusCode, e.RequestID)
+}
+
+func (e *ApiError) logFields() map[string]interface{} {
+	retVal := map[string]interface{}{
+		"request_id":  e.RequestID,
+		"http_method": e.Method,
+		"http_path":   e.Path,
+		"status_code": e.StatusCode,
+		"error_codes": strings.Join(helpers.Map(e.Details, func(d ApiErrorDetail) string { return d.Code }), ","),
+	}
+
+	validationErrors := helpers.Filter(e.Details, func(d ApiErrorDetail) bool { return d.Field != "" })
+	if len(validationErrors) > 0 {
+		retVal["validation_errors"] = helpers.Map(validationErrors, func(d ApiErrorDetail) string {
+			return fmt.Sprintf("%s: %s", d.Field, d.Message)
+		})
+	}
+
+	return retVal
+}
+
+func (e *ApiError) hint() string {
+	var retVal string
+
+	switch {
+	case e.IsUnauthorized():
+		retVal = "The ControlMonkey token is invalid or expired"
+	case e.IsForbidden():
+		retVal = "The ControlMonkey token is not permitted to perform this operation"
+	case e.IsConflict():
+		retVal = "The request conflicts with the current state of the entity"
+	case e.IsTooManyRequests():
+		retVal = "The ControlMonkey API rate limit was exceeded"
+	}
+
+	return retVal
+}
+
+func withReason(detail string, reason string) string {
+	if detail == "" {
+		return reason
+	}
+
+	return fmt.Sprintf("%s, error: %s", detail, reason)
+}
+
+var apiFieldSegmentRegex = regexp.MustCompile(`^([A-Za-z0-9_]+)((?:\[\d+])*)$`)
+var apiFieldIndexRegex = regexp.MustCompile(`\[(\d+)]`)
+
+// attributePathFromApiField converts a field as named by the API (e.g. `vcsInfo.repoName` or `rules[0].type`) into
+// the matching attribute path of the schema.
+func attributePathFromApiField(field string) (path.Path, bool) {
+	var retVal path.Path
+
+	field = strings.TrimPrefix(field, "entity.")
+	if field == "" {
+		return retVal, false
+	}
+
+	for i, segment := range strings.Split(field, ".") {
+		m := apiFieldSegmentRegex.FindStringSubmatch(segment)
+		if m == nil {
+			return path.Empty(), false
+		}
+
+		name := camelToSnakeCase(m[1])
+		if i == 0 {
+			retVal = path.Root(name)
+		} else {
+			retVal = retVal.AtName(name)
+		}
+
+		for _, index := range apiFieldIndexRegex.FindAllStringSubmatch(m[2], -1) {
+			n, _ := strconv.Atoi(index[1])
+			retVal = retVal.AtListIndex(n)
+		}
+	}
+
+	return retVal, true
+}
+
+func camelToSnakeCase(s string) string {
+	var sb strings.Builder
+
+	for i, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(c + ('a' - 'A'))
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+
+	return sb.String()
+}
+
+//endregion
@@ -0,0 +1,627 @@
+package fake_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+)
+
+const (
+	Token = "fake-token"
+
+	OrgId = "o-fake"
+
+	orgConfigurationPath = "/org/configuration"
+)
+
+// Server is an in-process fake of the ControlMonkey REST API with in-memory state. It speaks the same envelope as the
+// API ({"entity": ...} requests, {"response": {"items": [...]}} responses), so the SDK services work against it as is.
+type Server struct {
+	*httptest.Server
+
+	mu               sync.Mutex
+	collections      map[string]*collection
+	mappings         map[string]*mappingCollection
+	orgConfiguration map[string]interface{}
+	faults           []*fault
+	nextId           int
+}
+
+type entity = map[string]interface{}
+
+// collection holds entities addressable by ID, e.g. /stack and /stack/{id}.
+type collection struct {
+	idPrefix string
+	// params maps query parameters of the list request to the entity field they filter on.
+	params map[string]string
+	// uniqueField is rejected as already existing when another entity has the same value.
+	uniqueField string
+	// scoped collections filter on scope and scopeId using the stackId, namespaceId, templateId and orgOnly parameters.
+	scoped bool
+	// createKey is the field the create request nests the entity in, if it does, e.g. {"entity": {"stack": ...}}.
+	createKey string
+	// validate returns the reason a created or updated entity is rejected with a validation error, if it is.
+	validate func(entity) string
+
+	entities map[string]entity
+}
+
+// mappingCollection holds entities without an ID that are identified by their key fields, e.g. template namespace
+// mappings.
+type mappingCollection struct {
+	keyFields []string
+	// view converts a stored entity to the shape returned by the list request, if it differs.
+	view func(entity) entity
+	// upsert replaces an existing mapping on create instead of rejecting it, e.g. a namespace permission with a new role.
+	upsert bool
+
+	entities []entity
+}
+
+type fault struct {
+	method     string
+	pathPrefix string
+	statusCode int
+	remaining  int
+}
+
+// New starts a fake API with empty state. Close it when done.
+func New() *Server {
+	s := &Server{
+		collections: map[string]*collection{
+			"/stack":                           {idPrefix: "stk", params: map[string]string{"stackId": "id", "stackName": "name", "namespaceId": "namespaceId"}, createKey: "stack"},
+			"/stack/dependency":                {idPrefix: "dep"},
+			"/namespace":                       {idPrefix: "ns", params: map[string]string{"namespaceId": "id", "namespaceName": "name"}, uniqueField: "name"},
+			"/template":                        {idPrefix: "tmpl", params: map[string]string{"templateId": "id", "templateName": "name"}, uniqueField: "name"},
+			"/blueprint":                       {idPrefix: "blp", params: map[string]string{"blueprintId": "id", "blueprintName": "name"}, uniqueField: "name"},
+			"/variable":                        {idPrefix: "var", scoped: true, validate: validateValueConditions},
+			"/controlPolicy":                   {idPrefix: "cmp", params: map[string]string{"controlPolicyId": "id", "controlPolicyName": "name"}, uniqueField: "name"},
+			"/controlPolicyGroup":              {idPrefix: "cmpg", params: map[string]string{"controlPolicyGroupId": "id", "controlPolicyGroupName": "name"}, uniqueField: "name"},
+			"/iam/org/team":                    {idPrefix: "team", params: map[string]string{"teamId": "id", "teamName": "name"}, uniqueField: "name"},
+			"/iam/org/customRole":              {idPrefix: "cro", params: map[string]string{"customRoleId": "id", "customRoleName": "name"}, uniqueField: "name"},
+			"/iam/org/customAbacConfiguration": {idPrefix: "abac", params: map[string]string{"customAbacConfigurationId": "id", "customAbacConfigurationName": "name"}, uniqueField: "name"},
+			"/notification/endpoint":           {idPrefix: "ne", params: map[string]string{"endpointId": "id", "endpointName": "name"}, uniqueField: "name"},
+			"/notification/slackApp":           {idPrefix: "nsa", params: map[string]string{"slackAppId": "id", "slackAppName": "name"}, uniqueField: "name"},
+			"/notification/subscription":       {idPrefix: "nes", scoped: true},
+			"/stackDiscoveryConfiguration":     {idPrefix: "sdc"},
+			"/disasterRecovery/configuration":  {idPrefix: "drc"},
+		},
+		mappings: map[string]*mappingCollection{
+			"/template/templateNamespaceMapping":            {keyFields: []string{"templateId", "namespaceId"}},
+			"/blueprint/blueprintNamespaceMapping":          {keyFields: []string{"blueprintId", "namespaceId"}},
+			"/controlPolicy/controlPolicyMapping":           {keyFields: []string{"controlPolicyId", "targetId", "targetType"}},
+			"/controlPolicyGroup/controlPolicyGroupMapping": {keyFields: []string{"controlPolicyGroupId", "targetId", "targetType"}},
+			"/iam/org/namespacePermission":                  {keyFields: []string{"namespaceId", "userEmail", "programmaticUserName", "teamId"}, upsert: true},
+			"/iam/org/teamUser": {
+				keyFields: []string{"teamId", "userEmail"},
+				view:      func(e entity) entity { return entity{"email": e["userEmail"]} },
+			},
+		},
+	}
+
+	for _, c := range s.collections {
+		c.entities = make(map[string]entity)
+	}
+
+	// Like the API, the org configuration always exists and is empty until it is set.
+	s.orgConfiguration = entity{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+// Seed stores an entity in the collection of the given path, e.g. a control policy that tests expect to exist, and
+// returns its ID. An ID is generated when the entity has none.
+func (s *Server) Seed(path string, e map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[path]
+	if !ok {
+		panic(fmt.Sprintf("fake_api: unknown collection %s", path))
+	}
+
+	id, _ := e["id"].(string)
+	if id == "" {
+		id = s.newId(c.idPrefix)
+	}
+
+	stored := copyEntity(e)
+	stored["id"] = id
+	c.entities[id] = stored
+
+	return id
+}
+
+// Get returns a copy of a stored entity, e.g. to assert on what the provider sent.
+func (s *Server) Get(path string, id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[path]
+	if !ok {
+		return nil, false
+	}
+
+	e, ok := c.entities[id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyEntity(e), true
+}
+
+// InjectError makes the next requests with the given method whose path starts with pathPrefix fail with statusCode.
+// An empty method matches every method. times is the number of failing requests, 0 fails until ClearErrors.
+func (s *Server) InjectError(method string, pathPrefix string, statusCode int, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	remaining := times
+	if remaining == 0 {
+		remaining = -1
+	}
+
+	s.faults = append(s.faults, &fault{method: method, pathPrefix: pathPrefix, statusCode: statusCode, remaining: remaining})
+}
+
+// ClearErrors removes all injected errors.
+func (s *Server) ClearErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+//region Private
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "invalid token")
+		return
+	}
+
+	if f := s.matchFault(r); f != nil {
+		writeError(w, f.statusCode, errorCode(f.statusCode), "injected error")
+		return
+	}
+
+	body, err := readEntity(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	case path == orgConfigurationPath:
+		s.handleOrgConfiguration(w, r, body)
+	case s.mappings[path] != nil:
+		s.handleMapping(w, r, s.mappings[path], body)
+	default:
+		s.handleCollection(w, r, path, body)
+	}
+}
+
+func (s *Server) handleCollection(w http.ResponseWriter, r *http.Request, path string, body entity) {
+	collectionPath, id := s.route(path)
+	if collectionPath == "" {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no route for %s", path))
+		return
+	}
+
+	c := s.collections[collectionPath]
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		writeItems(w, c.list(r)...)
+	case id == "" && r.Method == http.MethodPost:
+		if nested, ok := body[c.createKey].(map[string]interface{}); ok {
+			body = nested
+		}
+
+		if c.isDuplicate("", body) {
+			writeError(w, http.StatusConflict, "already_exist", fmt.Sprintf("%s %v already exists", c.uniqueField, body[c.uniqueField]))
+			return
+		}
+
+		if reason := c.invalidReason(body); reason != "" {
+			writeError(w, http.StatusBadRequest, cmTypes.ErrorCodeValidationError, reason)
+			return
+		}
+
+		stored := copyEntity(body)
+		stored["id"] = s.newId(c.idPrefix)
+		c.entities[stored["id"].(string)] = stored
+		writeItems(w, stored)
+	case id != "":
+		stored, ok := c.entities[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s not found", collectionPath, id))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeItems(w, stored)
+		case http.MethodPut:
+			if c.isDuplicate(id, body) {
+				writeError(w, http.StatusConflict, "already_exist", fmt.Sprintf("%s %v already exists", c.uniqueField, body[c.uniqueField]))
+				return
+			}
+
+			updated := copyEntity(stored)
+			merge(updated, body)
+			if reason := c.invalidReason(updated); reason != "" {
+				writeError(w, http.StatusBadRequest, cmTypes.ErrorCodeValidationError, reason)
+				return
+			}
+
+			c.entities[id] = updated
+			writeItems(w, updated)
+		case http.MethodDelete:
+			delete(c.entities, id)
+			writeItems(w)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+func (s *Server) handleMapping(w http.ResponseWriter, r *http.Request, m *mappingCollection, body entity) {
+	switch r.Method {
+	case http.MethodGet:
+		var items []entity
+		for _, e := range m.entities {
+			if matchesQuery(e, r) {
+				if m.view != nil {
+					e = m.view(e)
+				}
+				items = append(items, e)
+			}
+		}
+		writeItems(w, items...)
+	case http.MethodPost:
+		stored := copyEntity(body)
+
+		if i := m.find(body); i >= 0 {
+			if !m.upsert {
+				writeError(w, http.StatusConflict, "already_exist", "mapping already exists")
+				return
+			}
+
+			m.entities[i] = stored
+		} else {
+			m.entities = append(m.entities, stored)
+		}
+
+		writeItems(w, stored)
+	case http.MethodPut:
+		i := m.find(body)
+		if i < 0 {
+			writeError(w, http.StatusNotFound, "not_found", "mapping not found")
+			return
+		}
+
+		merge(m.entities[i], body)
+		writeItems(w, m.entities[i])
+	case http.MethodDelete:
+		i := m.find(body)
+		if i < 0 {
+			writeError(w, http.StatusNotFound, "not_found", "mapping not found")
+			return
+		}
+
+		m.entities = append(m.entities[:i], m.entities[i+1:]...)
+		writeItems(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+func (s *Server) handleOrgConfiguration(w http.ResponseWriter, r *http.Request, body entity) {
+	switch r.Method {
+	case http.MethodGet:
+		writeItems(w, s.orgConfiguration)
+	case http.MethodPut:
+		merge(s.orgConfiguration, body)
+		writeItems(w, s.orgConfiguration)
+	case http.MethodDelete:
+		s.orgConfiguration = entity{}
+		writeItems(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+// route returns the longest collection path that the path belongs to, and the ID in the path if there is one.
+func (s *Server) route(path string) (string, string) {
+	var retVal string
+	var id string
+
+	for collectionPath := range s.collections {
+		if len(collectionPath) <= len(retVal) {
+			continue
+		}
+
+		if path == collectionPath {
+			retVal, id = collectionPath, ""
+		} else if rest, ok := strings.CutPrefix(path, collectionPath+"/"); ok && !strings.Contains(rest, "/") {
+			retVal, id = collectionPath, rest
+		}
+	}
+
+	return retVal, id
+}
+
+func (s *Server) matchFault(r *http.Request) *fault {
+	for i, f := range s.faults {
+		if (f.method == "" || f.method == r.Method) && strings.HasPrefix(r.URL.Path, f.pathPrefix) {
+			if f.remaining > 0 {
+				f.remaining--
+				if f.remaining == 0 {
+					s.faults = append(s.faults[:i], s.faults[i+1:]...)
+				}
+			}
+
+			return f
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) newId(prefix string) string {
+	s.nextId++
+	return fmt.Sprintf("%s-fake%d", prefix, s.nextId)
+}
+
+func (c *collection) list(r *http.Request) []entity {
+	ids := make([]string, 0, len(c.entities))
+	for id := range c.entities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var retVal []entity
+
+	for _, id := range ids {
+		e := c.entities[id]
+		if c.matches(e, r) {
+			retVal = append(retVal, e)
+		}
+	}
+
+	return retVal
+}
+
+func (c *collection) matches(e entity, r *http.Request) bool {
+	for param, values := range r.URL.Query() {
+		value := values[0]
+
+		if c.scoped {
+			scope, scopeId := e["scope"], e["scopeId"]
+
+			switch param {
+			case "stackId":
+				if scope != cmTypes.StackScope || scopeId != value {
+					return false
+				}
+				continue
+			case "namespaceId":
+				if scope != cmTypes.NamespaceScope || scopeId != value {
+					return false
+				}
+				continue
+			case "templateId":
+				if scope != cmTypes.TemplateScope || scopeId != value {
+					return false
+				}
+				continue
+			case "orgOnly":
+				if b, _ := strconv.ParseBool(value); b && scope != cmTypes.OrganizationScope {
+					return false
+				}
+				continue
+			}
+		}
+
+		if field, ok := c.params[param]; ok && e[field] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *collection) isDuplicate(id string, body entity) bool {
+	if c.uniqueField == "" || body[c.uniqueField] == nil {
+		return false
+	}
+
+	for otherId, e := range c.entities {
+		if otherId != id && e[c.uniqueField] == body[c.uniqueField] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *collection) invalidReason(e entity) string {
+	if c.validate == nil {
+		return ""
+	}
+
+	return c.validate(e)
+}
+
+// validateValueConditions rejects a numeric value of a variable that does not satisfy its numeric value conditions,
+// like the API does.
+func validateValueConditions(e entity) string {
+	conditions, _ := e["valueConditions"].([]interface{})
+	value, err := strconv.ParseFloat(fmt.Sprint(e["value"]), 64)
+	if len(conditions) == 0 || err != nil {
+		return ""
+	}
+
+	for _, c := range conditions {
+		condition, _ := c.(map[string]interface{})
+		limit, ok := condition["value"].(float64)
+		if !ok {
+			continue
+		}
+
+		var satisfied bool
+		switch condition["operator"] {
+		case cmTypes.Lt:
+			satisfied = value < limit
+		case cmTypes.Lte:
+			satisfied = value <= limit
+		case cmTypes.Gt:
+			satisfied = value > limit
+		case cmTypes.Gte:
+			satisfied = value >= limit
+		default:
+			continue
+		}
+
+		if !satisfied {
+			return fmt.Sprintf("value %v does not satisfy the condition %v %v", e["value"], condition["operator"], limit)
+		}
+	}
+
+	return ""
+}
+
+func (m *mappingCollection) find(body entity) int {
+	for i, e := range m.entities {
+		matches := true
+		for _, field := range m.keyFields {
+			if e[field] != body[field] {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func matchesQuery(e entity, r *http.Request) bool {
+	for param, values := range r.URL.Query() {
+		if e[param] != values[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func readEntity(r *http.Request) (entity, error) {
+	if r.Body == nil || r.ContentLength == 0 {
+		return entity{}, nil
+	}
+
+	var wrapper struct {
+		Entity entity `json:"entity"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&wrapper); err != nil {
+		return nil, err
+	}
+
+	if wrapper.Entity == nil {
+		return entity{}, nil
+	}
+
+	return wrapper.Entity, nil
+}
+
+// merge applies an update the way the API does: fields sent as null are cleared, omitted fields are kept and nested
+// objects are merged the same way. Lists are replaced.
+func merge(stored entity, update entity) {
+	for k, v := range update {
+		if k == "id" {
+			continue
+		}
+
+		storedObject, storedIsObject := stored[k].(map[string]interface{})
+		updateObject, updateIsObject := v.(map[string]interface{})
+
+		switch {
+		case v == nil:
+			delete(stored, k)
+		case storedIsObject && updateIsObject:
+			merge(storedObject, updateObject)
+		default:
+			stored[k] = v
+		}
+	}
+}
+
+// copyEntity returns a deep copy of the nested objects of the entity, so merging into the copy leaves e untouched.
+func copyEntity(e entity) entity {
+	retVal := make(entity, len(e))
+	for k, v := range e {
+		if object, ok := v.(map[string]interface{}); ok {
+			v = copyEntity(object)
+		}
+		retVal[k] = v
+	}
+
+	return retVal
+}
+
+func writeItems(w http.ResponseWriter, items ...entity) {
+	if items == nil {
+		items = []entity{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"request":  map[string]interface{}{"id": "fake-request"},
+		"response": map[string]interface{}{"items": items},
+	})
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code string, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"request":  map[string]interface{}{"id": "fake-request"},
+		"response": map[string]interface{}{"errors": []map[string]interface{}{{"code": code, "message": message}}},
+	})
+}
+
+func errorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusNotFound:
+		return "not_found"
+	case http.StatusConflict:
+		return "conflict"
+	case http.StatusTooManyRequests:
+		return "too_many_requests"
+	default:
+		return strings.ReplaceAll(strings.ToLower(http.StatusText(statusCode)), " ", "_")
+	}
+}
+
+//endregion
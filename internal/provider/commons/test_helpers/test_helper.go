@@ -15,3 +15,7 @@ func GetValidateNoDriftStep() resource.TestStep {
 		},
 	}
 }
+
+// NamePrefix starts the name of every object the acceptance tests create, so the sweepers can find the objects that
+// aborted tests left behind.
+const NamePrefix = "tf-acc-"
@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	stdlog "log"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
@@ -22,15 +25,16 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/featureflag"
-	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/log"
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
 	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
 	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
 	"github.com/control-monkey/controlmonkey-sdk-go/services/stack_discovery_configuration"
 	"github.com/control-monkey/controlmonkey-sdk-go/services/variable"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	"github.com/control-monkey/terraform-provider-cm/version"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"golang.org/x/time/rate"
 )
 
 var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
@@ -38,9 +42,17 @@ var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
 	"providers/internal/index.html\nfor more information on providing " +
 	"credentials for ControlMonkey Provider.")
 
+// EndpointEnvVar sets the API endpoint when the provider configuration or the tools do not.
+const EndpointEnvVar = "CONTROL_MONKEY_ENDPOINT"
+
 type Config struct {
-	Token        string
-	FeatureFlags string
+	Token                 string
+	Endpoint              string
+	Profile               string
+	SharedCredentialsFile string
+	FeatureFlags          map[string]bool
+	RequestsPerSecond     float64
+	Burst                 int
 
 	terraformVersion string
 }
@@ -68,7 +80,7 @@ func (c *Config) Client() (*Client, diag.Diagnostics) {
 	stdlog.Println("[INFO] Configuring a new ControlMonkey client")
 
 	// Create a new session.
-	sess, err := c.getSession()
+	sess, err := c.getSession(newRequestsLimiter(c.RequestsPerSecond, c.Burst))
 	if err != nil {
 		diags := new(diag.Diagnostics)
 		diags.AddError("Failed to configure ControlMonkey client", err.Error())
@@ -98,12 +110,63 @@ func (c *Config) Client() (*Client, diag.Diagnostics) {
 	return client, nil
 }
 
-func (c *Config) getSession() (*session.Session, error) {
+// validateCredentials reads the org configuration, a lightweight call of the SDK that every token may send. Only a
+// token the API rejects fails, other errors are logged and left to the resources: a 404 or a 403 of a role that may
+// not read the org configuration say nothing about the token.
+func (c *Client) validateCredentials(ctx context.Context) error {
+	_, err := c.organization.ReadOrgConfiguration(ctx)
+	if err == nil {
+		return nil
+	}
+
+	if apiErr, ok := commons.AsApiError(err); ok && apiErr.IsUnauthorized() {
+		return err
+	}
+
+	stdlog.Printf("[WARN] Failed to validate the ControlMonkey credentials, continuing: %v", err)
+	return nil
+}
+
+// Session configures and returns a session of the SDK, with the same credentials, endpoint and transport as the
+// client. Tools that call the services of the SDK directly, such as cm-export, create them with it.
+func (c *Config) Session() (*session.Session, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sess, err := c.getSession(newRequestsLimiter(c.RequestsPerSecond, c.Burst))
+	if err != nil {
+		diags.AddError("Failed to configure ControlMonkey client", err.Error())
+		return nil, diags
+	}
+
+	return sess, diags
+}
+
+func (c *Config) getSession(limiter *rate.Limiter) (*session.Session, error) {
 	config := controlmonkey.DefaultConfig()
 
+	// Endpoint.
+	if shared := c.getSharedCredentialsProvider(); shared != nil {
+		endpoint, err := shared.endpoint()
+		if err != nil && c.Token == "" {
+			return nil, err
+		}
+		if endpoint != "" {
+			config.WithBaseURL(endpoint)
+		}
+	}
+	if c.Endpoint != "" {
+		if _, err := url.ParseRequestURI(c.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %w", c.Endpoint, err)
+		}
+		config.WithBaseURL(c.Endpoint)
+	}
+
 	// HTTP options.
 	{
-		config.WithHTTPClient(cleanhttp.DefaultPooledClient())
+		httpClient := cleanhttp.DefaultPooledClient()
+		httpClient.Transport = newRateLimitedTransport(newLoggingTransport(httpClient.Transport), limiter)
+
+		config.WithHTTPClient(httpClient)
 		config.WithUserAgent(c.getUserAgent())
 	}
 
@@ -116,13 +179,6 @@ func (c *Config) getSession() (*session.Session, error) {
 		config.WithCredentials(v)
 	}
 
-	// Logging.
-	{
-		config.WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
-			stdlog.Printf(fmt.Sprintf("[DEBUG] [internal-sdk-go] %s", format), args...)
-		}))
-	}
-
 	return session.New(config), nil
 }
 
@@ -152,28 +208,25 @@ func (c *Config) getUserAgent() string {
 	return ua
 }
 
+// getCredentials returns credentials owned by this Config only: the configured token, or else the selected profile of
+// the shared credentials file. The shared credentials file is only read when a profile or a file were selected.
+// Feature flags are read from the Config and never from the package global flags of the SDK.
 func (c *Config) getCredentials() (*credentials.Credentials, error) {
 	var providers []credentials.Provider
-	var static *credentials.StaticProvider
-
-	featureflag.Set(c.FeatureFlags)
 
 	if c.Token != "" {
-		static = &credentials.StaticProvider{
+		providers = append(providers, &credentials.StaticProvider{
 			Value: credentials.Value{
 				Token: c.Token,
 			},
-		}
-	}
-	if static != nil {
-		providers = append(providers, static)
+		})
 	}
 
-	providers = append(providers,
-		new(credentials.EnvProvider),
-		new(credentials.FileProvider))
+	if shared := c.getSharedCredentialsProvider(); shared != nil {
+		providers = append(providers, shared)
+	}
 
-	creds := credentials.NewChainCredentials(providers...)
+	creds := credentials.NewCredentials(newCredentialsChain(c.FeatureFlags[featureflag.MergeCredentialsChain.Name()], providers...))
 
 	if _, err := creds.Get(); err != nil {
 		stdlog.Printf("[ERROR] Failed to instantiate ControlMonkey client: %v", err)
@@ -182,3 +235,39 @@ func (c *Config) getCredentials() (*credentials.Credentials, error) {
 
 	return creds, nil
 }
+
+// getSharedCredentialsProvider returns the provider of the selected profile of the shared credentials file, or nil
+// when neither a profile nor a file were selected, in the provider configuration or the environment.
+func (c *Config) getSharedCredentialsProvider() *sharedCredentialsProvider {
+	retVal := newSharedCredentialsProvider(c.SharedCredentialsFile, c.Profile)
+
+	if retVal.explicitProfile == false && retVal.explicitFilename == false {
+		return nil
+	}
+
+	return retVal
+}
+
+// parseFeatureFlags parses features from a string like "feature1=true,feature2=false", the same way as the SDK does.
+// A feature without a value is enabled.
+func parseFeatureFlags(features string) map[string]bool {
+	retVal := make(map[string]bool)
+
+	for _, s := range strings.Split(strings.TrimSpace(features), ",") {
+		if len(s) == 0 {
+			continue
+		}
+
+		segments := strings.SplitN(s, "=", 2)
+		name := strings.TrimSpace(segments[0])
+
+		enabled := true
+		if len(segments) > 1 {
+			enabled, _ = strconv.ParseBool(strings.TrimSpace(segments[1])) // invalid values fall back to `false`
+		}
+
+		retVal[name] = enabled
+	}
+
+	return retVal
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+)
+
+func TestParseFeatureFlags(t *testing.T) {
+	got := parseFeatureFlags(" MergeCredentialsChain, Alpha=false,Beta=true,Gamma=invalid ")
+	want := map[string]bool{"MergeCredentialsChain": true, "Alpha": false, "Beta": true, "Gamma": false}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestConfigCredentialsArePerInstance(t *testing.T) {
+	t.Setenv(credentials.EnvCredentialsVarToken, "env-token")
+	t.Setenv(credentials.FileCredentialsEnvVarFile, t.TempDir()+"/missing")
+
+	first := Config{Token: "first-token", FeatureFlags: map[string]bool{"MergeCredentialsChain": true}}
+	second := Config{Token: "second-token"}
+
+	for _, c := range []Config{first, second} {
+		creds, err := c.getCredentials()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		v, _ := creds.Get()
+		if v.Token != c.Token {
+			t.Errorf("expected token %s, got %s", c.Token, v.Token)
+		}
+	}
+
+	if _, err := (&Config{}).getCredentials(); err == nil {
+		t.Error("expected a Config without token to ignore CONTROL_MONKEY_TOKEN of the process and fail")
+	}
+}
+
+func TestValidateCredentials(t *testing.T) {
+	cases := map[string]struct {
+		status        int
+		expectedError bool
+	}{
+		"valid token":                     {status: http.StatusOK},
+		"rejected token":                  {status: http.StatusUnauthorized, expectedError: true},
+		"org configuration not found":     {status: http.StatusNotFound},
+		"org configuration not permitted": {status: http.StatusForbidden},
+		"org configuration not available": {status: http.StatusServiceUnavailable},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/org/configuration" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(`{"response":{"items":[{}]}}`))
+			}))
+			defer server.Close()
+
+			client, diags := (&Config{Token: "token", Endpoint: server.URL}).Client()
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			if err := client.validateCredentials(context.Background()); (err != nil) != c.expectedError {
+				t.Errorf("expected error %t, got %v", c.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestConfigInvalidEndpoint(t *testing.T) {
+	if _, diags := (&Config{Token: "token", Endpoint: "api.example.com"}).Client(); !diags.HasError() {
+		t.Error("expected an error for an endpoint that is not a URL")
+	}
+}
@@ -8,6 +8,7 @@ const (
 	resourceNotFoundError                = "Resource not found"
 	resourceUpdateFailedError            = "Resource update failed"
 	multipleEntitiesError                = "Found multiple entities"
+	operationTimeoutError                = "Operation timed out"
 	blueprintNotFoundError               = "Blueprint not found"
 	controlPolicyGroupNotFoundError      = "Control Policy Group not found"
 	controlPolicyNotFoundError           = "Control Policy not found"
@@ -15,6 +16,8 @@ const (
 	customRoleNotFoundError              = "Custom Role not found"
 	notificationEndpointNotFoundError    = "Notification Endpoint not found"
 	namespaceNotFoundError               = "Namespace not found"
+	orgConfigurationNotFoundError        = "Org Configuration not found"
 	stackNotFoundError                   = "Stack not found"
 	templateNotFoundError                = "Template not found"
+	effectiveConfigNotResolvedWarning    = "Effective configuration not resolved"
 )
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	sdkControlPolicy "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	tfControlPolicy "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_data"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ControlPoliciesDataSource{}
+
+func NewControlPoliciesDataSource() datasource.DataSource {
+	return &ControlPoliciesDataSource{}
+}
+
+type ControlPoliciesDataSource struct {
+	client *ControlMonkeyAPIClient
+}
+
+func (r *ControlPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_control_policies"
+}
+
+func (r *ControlPoliciesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nameFilterAttributes("control policies")
+	attributes["control_policies"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The control policies matching the filters.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: resourceComputedAttributes(ctx, NewControlPolicyResource(), &resp.Diagnostics),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists control policies, optionally filtered by name.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *ControlPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ControlMonkeyAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ControlMonkeyAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ControlPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//Get current state
+	var state tfControlPolicy.ListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newNameFilter(state.NamePrefix, state.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeManaged := true
+	res, err := r.client.Client.controlPolicy.ListControlPolicies(ctx, nil, nil, &includeManaged)
+	if err != nil {
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to list control policies", "", err)...)
+		return
+	}
+
+	res = helpers.Filter(res, func(e *sdkControlPolicy.ControlPolicy) bool { return filter.matches(e.Name) })
+
+	tfControlPolicy.UpdateStateAfterList(res, &state)
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
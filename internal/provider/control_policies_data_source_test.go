@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccControlPoliciesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "cm_control_policy" "control_policy" {
+  name = "`+test_helpers.NamePrefix+`Control Policies Data Source Unique"
+  type = "aws_denied_regions"
+  parameters = jsonencode({
+    regions = ["us-east-1"]
+  })
+}
+data "cm_control_policies" "control_policies" {
+  name_prefix = "`+test_helpers.NamePrefix+`Control Policies Data Source"
+
+  depends_on = [cm_control_policy.control_policy]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_control_policies.control_policies", "control_policies.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_control_policies.control_policies", "control_policies.0.id"),
+					resource.TestCheckResourceAttr("data.cm_control_policies.control_policies", "control_policies.0.name", test_helpers.NamePrefix+"Control Policies Data Source Unique"),
+					resource.TestCheckResourceAttr("data.cm_control_policies.control_policies", "control_policies.0.type", "aws_denied_regions"),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfControlPolicy "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -69,6 +70,11 @@ func (r *ControlPolicyDataSource) Configure(_ context.Context, req datasource.Co
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ControlPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Get current state
 	var state tfControlPolicy.ResourceModel
 
@@ -83,7 +89,7 @@ func (r *ControlPolicyDataSource) Read(ctx context.Context, req datasource.ReadR
 	res, err := r.client.Client.controlPolicy.ListControlPolicies(ctx, controlPolicyId, name, &includeManaged)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read control policy"), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read control policy"), "", err)...)
 		return
 	} else if len(res) == 0 {
 		resp.Diagnostics.AddError(fmt.Sprintf(resourceNotFoundError), fmt.Sprintf(controlPolicyNotFoundError))
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -19,7 +20,7 @@ func TestAccControlPolicyDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_control_policy" "control_policy" {
-  name = "Control Policy Unique"
+  name = "`+test_helpers.NamePrefix+`Control Policy Unique"
   type = "aws_denied_regions"
   parameters = jsonencode({
     regions = ["us-east-1"]
@@ -32,7 +33,7 @@ data "cm_control_policy" "control_policy" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_control_policy.control_policy", "id"),
-					resource.TestCheckResourceAttr("data.cm_control_policy.control_policy", "name", "Control Policy Unique"),
+					resource.TestCheckResourceAttr("data.cm_control_policy.control_policy", "name", test_helpers.NamePrefix+"Control Policy Unique"),
 				),
 			},
 			{
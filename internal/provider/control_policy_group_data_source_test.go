@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -15,7 +16,7 @@ func TestAccControlPolicyGroupDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_control_policy" "control_policy" {
-  name = "Control Policy"
+  name = "`+test_helpers.NamePrefix+`Control Policy"
   type = "aws_denied_regions"
   parameters = jsonencode({
     regions = ["us-east-1"]
@@ -23,7 +24,7 @@ resource "cm_control_policy" "control_policy" {
 }
 
 resource "cm_control_policy_group" "control_policy_group" {
-  name = "Control Policy Group Unique"
+  name = "`+test_helpers.NamePrefix+`Control Policy Group Unique"
   control_policies = [
 	{
 	  control_policy_id = cm_control_policy.control_policy.id
@@ -37,7 +38,7 @@ data "cm_control_policy_group" "control_policy_group" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_control_policy_group.control_policy_group", "id"),
-					resource.TestCheckResourceAttr("data.cm_control_policy_group.control_policy_group", "name", "Control Policy Group Unique"),
+					resource.TestCheckResourceAttr("data.cm_control_policy_group.control_policy_group", "name", test_helpers.NamePrefix+"Control Policy Group Unique"),
 				),
 			},
 		},
@@ -41,7 +41,7 @@ func (r *ControlPolicyGroupMappingResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_control_policy_group_mappings"
 }
 
-func (r *ControlPolicyGroupMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ControlPolicyGroupMappingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys control policy group mappings.",
 		Attributes: map[string]schema.Attribute{
@@ -140,6 +140,9 @@ func (r *ControlPolicyGroupMappingResource) Schema(_ context.Context, _ resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -201,6 +204,10 @@ func (r *ControlPolicyGroupMappingResource) ValidateConfig(ctx context.Context,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ControlPolicyGroupMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state controlPolicyGroupMapping.ResourceModel
 
@@ -211,6 +218,12 @@ func (r *ControlPolicyGroupMappingResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.controlPolicyGroup.ListControlPolicyGroupMappings(ctx, id)
 	if err != nil {
@@ -220,7 +233,7 @@ func (r *ControlPolicyGroupMappingResource) Read(ctx context.Context, req resour
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read mappings for control policy group '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read mappings for control policy group '%s'", id), "", err)...)
 		return
 	}
 
@@ -236,6 +249,12 @@ func (r *ControlPolicyGroupMappingResource) Read(ctx context.Context, req resour
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ControlPolicyGroupMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan controlPolicyGroupMapping.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -244,6 +263,12 @@ func (r *ControlPolicyGroupMappingResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := controlPolicyGroupMapping.Merge(&plan, nil, commons.CreateConverter)
 	controlPolicyGroupId := plan.ControlPolicyGroupId
 
@@ -264,6 +289,12 @@ func (r *ControlPolicyGroupMappingResource) Create(ctx context.Context, req reso
 }
 
 func (r *ControlPolicyGroupMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan controlPolicyGroupMapping.ResourceModel
 	var state controlPolicyGroupMapping.ResourceModel
@@ -277,6 +308,12 @@ func (r *ControlPolicyGroupMappingResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := controlPolicyGroupMapping.Merge(&plan, &state, commons.UpdateMerger)
 	controlPolicyGroupId := plan.ControlPolicyGroupId
 
@@ -307,6 +344,12 @@ func (r *ControlPolicyGroupMappingResource) Update(ctx context.Context, req reso
 }
 
 func (r *ControlPolicyGroupMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state controlPolicyGroupMapping.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -315,6 +358,12 @@ func (r *ControlPolicyGroupMappingResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := controlPolicyGroupMapping.Merge(nil, &state, commons.DeleteMerger)
 	controlPolicyGroupId := state.ControlPolicyGroupId
 
@@ -340,14 +389,9 @@ func (r *ControlPolicyGroupMappingResource) createEntities(ctx context.Context,
 			if commons.IsAlreadyExistResponseError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Target '%s' of type '%s' is already mapped to control policy group '%s'. No operation was made.", targetId, targetType, controlPolicyGroupId))
 			} else if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy group '%s'. Error: %s", targetType, targetId, controlPolicyGroupId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId), "", err)
 			}
 		}
 	}
@@ -367,14 +411,9 @@ func (r *ControlPolicyGroupMappingResource) updateEntities(ctx context.Context,
 			targetId := *e.TargetId
 			targetType := *e.TargetType
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy group '%s'. Error: %s", targetType, targetId, controlPolicyGroupId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId), "", err)
 			}
 		}
 	}
@@ -394,14 +433,9 @@ func (r *ControlPolicyGroupMappingResource) deleteEntities(ctx context.Context,
 			targetId := *e.TargetId
 			targetType := *e.TargetType
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy group '%s'. Error: %s", targetType, targetId, controlPolicyGroupId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy group '%s'", targetType, targetId, controlPolicyGroupId), "", err)
 			}
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -24,20 +25,20 @@ func testAccControlPolicyGroupMappingResourceSetup() string {
 
 	return fmt.Sprintf(`
 resource "cm_namespace" "dev_namespace" {
-  name = "Dev"
+  name = "`+test_helpers.NamePrefix+`Dev"
 }
 
 resource "cm_namespace" "dev_namespace2" {
-  name = "Dev2"
+  name = "`+test_helpers.NamePrefix+`Dev2"
 }
 
 resource "cm_namespace" "test_target_namespace" {
-  name = "TestTarget"
+  name = "`+test_helpers.NamePrefix+`TestTarget"
 }
 resource "cm_stack" "target" {
   iac_type     = "terraform"
   namespace_id = cm_namespace.dev_namespace.id
-  name         = "Stack Name"
+  name         = "`+test_helpers.NamePrefix+`Stack Name"
   deployment_behavior = {
     deploy_on_push    = false
   }
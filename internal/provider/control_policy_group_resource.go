@@ -6,11 +6,13 @@ import (
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkControlPolicyGroup "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy_group"
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfControlPolicyGroup "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_group"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -35,7 +37,7 @@ func (r *ControlPolicyGroupResource) Metadata(_ context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_control_policy_group"
 }
 
-func (r *ControlPolicyGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ControlPolicyGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys control policy groups.",
 		Attributes: map[string]schema.Attribute{
@@ -80,6 +82,10 @@ func (r *ControlPolicyGroupResource) Schema(_ context.Context, _ resource.Schema
 				},
 				Validators: []validator.List{listvalidator.SizeAtLeast(1)},
 			},
+			adoptExistingAttributeName: adoptExistingAttribute("control policy group", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -107,6 +113,10 @@ func (r *ControlPolicyGroupResource) Configure(_ context.Context, req resource.C
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ControlPolicyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state tfControlPolicyGroup.ResourceModel
 
@@ -117,6 +127,12 @@ func (r *ControlPolicyGroupResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.controlPolicyGroup.ReadControlPolicyGroup(ctx, id)
 
@@ -127,7 +143,7 @@ func (r *ControlPolicyGroupResource) Read(ctx context.Context, req resource.Read
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read control policy group '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read control policy group '%s'", id), "", err)...)
 		return
 	}
 
@@ -143,6 +159,12 @@ func (r *ControlPolicyGroupResource) Read(ctx context.Context, req resource.Read
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ControlPolicyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfControlPolicyGroup.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -151,14 +173,31 @@ func (r *ControlPolicyGroupResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := tfControlPolicyGroup.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.controlPolicyGroup.CreateControlPolicyGroup(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create control policy group, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create control policy group", err)...)
 		return
 	}
 
@@ -173,6 +212,12 @@ func (r *ControlPolicyGroupResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *ControlPolicyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfControlPolicyGroup.ResourceModel
 	var state tfControlPolicyGroup.ResourceModel
@@ -186,6 +231,12 @@ func (r *ControlPolicyGroupResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfControlPolicyGroup.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -196,10 +247,7 @@ func (r *ControlPolicyGroupResource) Update(ctx context.Context, req resource.Up
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update control policy group %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update control policy group %s", id), err)...)
 		return
 	}
 
@@ -212,6 +260,12 @@ func (r *ControlPolicyGroupResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *ControlPolicyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfControlPolicyGroup.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -220,6 +274,12 @@ func (r *ControlPolicyGroupResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.controlPolicyGroup.DeleteControlPolicyGroup(ctx, id)
 
@@ -229,10 +289,7 @@ func (r *ControlPolicyGroupResource) Delete(ctx context.Context, req resource.De
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete control policy group %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete control policy group %s", id), err)...)
 		return
 	}
 }
@@ -240,3 +297,40 @@ func (r *ControlPolicyGroupResource) Delete(ctx context.Context, req resource.De
 func (r *ControlPolicyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing control policy group with the planned name and applies the plan to it. It returns
+// false when there is no such control policy group.
+func (r *ControlPolicyGroupResource) adoptExisting(ctx context.Context, plan *tfControlPolicyGroup.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkControlPolicyGroup.ControlPolicyGroup]{
+		Kind: "control policy group",
+		List: func(ctx context.Context) ([]*sdkControlPolicyGroup.ControlPolicyGroup, error) {
+			return r.client.Client.controlPolicyGroup.ListControlPolicyGroups(ctx, nil, plan.Name.ValueStringPointer(), nil)
+		},
+		Match: func(e *sdkControlPolicyGroup.ControlPolicyGroup) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkControlPolicyGroup.ControlPolicyGroup) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.controlPolicyGroup.ReadControlPolicyGroup,
+		Update: func(ctx context.Context, id string, existing *sdkControlPolicyGroup.ControlPolicyGroup) error {
+			var state tfControlPolicyGroup.ResourceModel
+			tfControlPolicyGroup.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := tfControlPolicyGroup.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.controlPolicyGroup.UpdateControlPolicyGroup(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -14,17 +15,17 @@ const (
 	tfControlPolicyGroupResource     = "cm_control_policy_group"
 	controlPolicyGroupTfResourceName = "control_policy_group"
 
-	controlPolicyGroupName        = "tf control policy group"
+	controlPolicyGroupName        = test_helpers.NamePrefix + "tf control policy group"
 	controlPolicyGroupDescription = "test"
 
 	controlPolicySeverityAfterUpdate  = "high"
-	controlPolicyGroupNameAfterUpdate = "updated tf control policy group"
+	controlPolicyGroupNameAfterUpdate = test_helpers.NamePrefix + "updated tf control policy group"
 )
 
 func testAccControlPolicyGroupResourceSetup() string {
 	return `
 resource "cm_control_policy" "control_policy_test" {
-  name        = "AWS Resources should have the Env tag with value Dev/Stage/Prod"
+  name        = "` + test_helpers.NamePrefix + `AWS Resources should have the Env tag with value Dev/Stage/Prod"
   description = "All AWS infrastructure should have the Env tag with value Dev/Stage/Prod."
   type        = "aws_required_tags"
   parameters  = jsonencode({
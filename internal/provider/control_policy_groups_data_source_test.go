@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccControlPolicyGroupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "cm_control_policy" "control_policy" {
+  name = "`+test_helpers.NamePrefix+`Control Policies Data Source Unique"
+  type = "aws_denied_regions"
+  parameters = jsonencode({
+    regions = ["us-east-1"]
+  })
+}
+
+resource "cm_control_policy_group" "control_policy_group" {
+  name = "`+test_helpers.NamePrefix+`Control Policy Groups Data Source Unique"
+  control_policies = [
+    {
+      control_policy_id = cm_control_policy.control_policy.id
+    }
+  ]
+}
+data "cm_control_policy_groups" "control_policy_groups" {
+  name_prefix = "`+test_helpers.NamePrefix+`Control Policy Groups Data Source"
+
+  depends_on = [cm_control_policy_group.control_policy_group]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_control_policy_groups.control_policy_groups", "control_policy_groups.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_control_policy_groups.control_policy_groups", "control_policy_groups.0.id"),
+					resource.TestCheckResourceAttr("data.cm_control_policy_groups.control_policy_groups", "control_policy_groups.0.name", test_helpers.NamePrefix+"Control Policy Groups Data Source Unique"),
+					resource.TestCheckResourceAttr("data.cm_control_policy_groups.control_policy_groups", "control_policy_groups.0.control_policies.#", "1"),
+				),
+			},
+		},
+	})
+}
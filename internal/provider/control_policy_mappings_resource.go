@@ -41,7 +41,7 @@ func (r *ControlPolicyMappingResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_control_policy_mappings"
 }
 
-func (r *ControlPolicyMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ControlPolicyMappingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys control policy mappings.",
 		Attributes: map[string]schema.Attribute{
@@ -109,6 +109,9 @@ func (r *ControlPolicyMappingResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -154,6 +157,10 @@ func (r *ControlPolicyMappingResource) ValidateConfig(ctx context.Context, req r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ControlPolicyMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state controlPolicyMapping.ResourceModel
 
@@ -164,6 +171,12 @@ func (r *ControlPolicyMappingResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.controlPolicy.ListControlPolicyMappings(ctx, id)
 	if err != nil {
@@ -173,7 +186,7 @@ func (r *ControlPolicyMappingResource) Read(ctx context.Context, req resource.Re
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read mappings for control policy '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read mappings for control policy '%s'", id), "", err)...)
 		return
 	}
 
@@ -189,6 +202,12 @@ func (r *ControlPolicyMappingResource) Read(ctx context.Context, req resource.Re
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ControlPolicyMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan controlPolicyMapping.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -197,6 +216,12 @@ func (r *ControlPolicyMappingResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := controlPolicyMapping.Merge(&plan, nil, commons.CreateConverter)
 	controlPolicyId := plan.ControlPolicyId
 
@@ -217,6 +242,12 @@ func (r *ControlPolicyMappingResource) Create(ctx context.Context, req resource.
 }
 
 func (r *ControlPolicyMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan controlPolicyMapping.ResourceModel
 	var state controlPolicyMapping.ResourceModel
@@ -230,6 +261,12 @@ func (r *ControlPolicyMappingResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := controlPolicyMapping.Merge(&plan, &state, commons.UpdateMerger)
 	controlPolicyId := plan.ControlPolicyId
 
@@ -260,6 +297,12 @@ func (r *ControlPolicyMappingResource) Update(ctx context.Context, req resource.
 }
 
 func (r *ControlPolicyMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state controlPolicyMapping.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -268,6 +311,12 @@ func (r *ControlPolicyMappingResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := controlPolicyMapping.Merge(nil, &state, commons.DeleteMerger)
 	controlPolicyId := state.ControlPolicyId
 
@@ -293,14 +342,9 @@ func (r *ControlPolicyMappingResource) createEntities(ctx context.Context, entit
 			if commons.IsAlreadyExistResponseError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Target '%s' of type '%s' is already mapped to control policy '%s'. No operation was made.", targetId, targetType, controlPolicyId))
 			} else if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy '%s'. Error: %s", targetType, targetId, controlPolicyId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to create map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId), "", err)
 			}
 		}
 	}
@@ -320,14 +364,9 @@ func (r *ControlPolicyMappingResource) updateEntities(ctx context.Context, entit
 			targetId := *e.TargetId
 			targetType := *e.TargetType
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy '%s'. Error: %s", targetType, targetId, controlPolicyId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to update map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId), "", err)
 			}
 		}
 	}
@@ -347,14 +386,9 @@ func (r *ControlPolicyMappingResource) deleteEntities(ctx context.Context, entit
 			targetId := *e.TargetId
 			targetType := *e.TargetType
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy '%s'. Error: %s", targetType, targetId, controlPolicyId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to delete map between target '%s' of type '%s' and control policy '%s'", targetType, targetId, controlPolicyId), "", err)
 			}
 		}
 	}
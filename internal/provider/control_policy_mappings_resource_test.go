@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -21,7 +22,7 @@ func testAccControlPolicyMappingResourceSetup() string {
 
 	return fmt.Sprintf(`
 resource "cm_control_policy" "test_control_policy" {
-  name = "Control Policy Unique"
+  name = "`+test_helpers.NamePrefix+`Control Policy Unique"
   type = "aws_denied_regions"
   parameters = jsonencode({
     regions = ["us-east-1"]
@@ -29,17 +30,17 @@ resource "cm_control_policy" "test_control_policy" {
 }
 
 resource "cm_namespace" "dev_namespace" {
-  name = "Dev"
+  name = "`+test_helpers.NamePrefix+`Dev"
 }
 
 resource "cm_namespace" "dev_namespace2" {
-  name = "Dev2"
+  name = "`+test_helpers.NamePrefix+`Dev2"
 }
 
 resource "cm_stack" "target" {
   iac_type     = "terraform"
   namespace_id = cm_namespace.dev_namespace.id
-  name         = "Stack Name"
+  name         = "`+test_helpers.NamePrefix+`Stack Name"
   deployment_behavior = {
     deploy_on_push    = false
   }
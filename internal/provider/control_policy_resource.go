@@ -5,10 +5,12 @@ import (
 	"fmt"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	sdkControlPolicy "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfControlPolicy "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +35,7 @@ func (r *ControlPolicyResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_control_policy"
 }
 
-func (r *ControlPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ControlPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys control policies.",
 		Attributes: map[string]schema.Attribute{
@@ -67,6 +69,10 @@ func (r *ControlPolicyResource) Schema(_ context.Context, _ resource.SchemaReque
 				Required:            true,
 				CustomType:          jsontypes.NormalizedType{},
 			},
+			adoptExistingAttributeName: adoptExistingAttribute("control policy", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -94,6 +100,10 @@ func (r *ControlPolicyResource) Configure(_ context.Context, req resource.Config
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ControlPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state tfControlPolicy.ResourceModel
 
@@ -104,6 +114,12 @@ func (r *ControlPolicyResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.controlPolicy.ReadControlPolicy(ctx, id)
 
@@ -114,7 +130,7 @@ func (r *ControlPolicyResource) Read(ctx context.Context, req resource.ReadReque
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read control policy '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read control policy '%s'", id), "", err)...)
 		return
 	}
 
@@ -130,6 +146,12 @@ func (r *ControlPolicyResource) Read(ctx context.Context, req resource.ReadReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ControlPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfControlPolicy.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -138,14 +160,31 @@ func (r *ControlPolicyResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := tfControlPolicy.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.controlPolicy.CreateControlPolicy(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create control policy, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create control policy", err)...)
 		return
 	}
 
@@ -160,6 +199,12 @@ func (r *ControlPolicyResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *ControlPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfControlPolicy.ResourceModel
 	var state tfControlPolicy.ResourceModel
@@ -173,6 +218,12 @@ func (r *ControlPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfControlPolicy.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -183,10 +234,7 @@ func (r *ControlPolicyResource) Update(ctx context.Context, req resource.UpdateR
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update control policy %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update control policy %s", id), err)...)
 		return
 	}
 
@@ -199,6 +247,12 @@ func (r *ControlPolicyResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *ControlPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfControlPolicy.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -207,6 +261,12 @@ func (r *ControlPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.controlPolicy.DeleteControlPolicy(ctx, id)
 
@@ -216,10 +276,7 @@ func (r *ControlPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete control policy %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete control policy %s", id), err)...)
 		return
 	}
 }
@@ -227,3 +284,40 @@ func (r *ControlPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *ControlPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing control policy with the planned name and applies the plan to it. It returns
+// false when there is no such control policy.
+func (r *ControlPolicyResource) adoptExisting(ctx context.Context, plan *tfControlPolicy.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkControlPolicy.ControlPolicy]{
+		Kind: "control policy",
+		List: func(ctx context.Context) ([]*sdkControlPolicy.ControlPolicy, error) {
+			return r.client.Client.controlPolicy.ListControlPolicies(ctx, nil, plan.Name.ValueStringPointer(), nil)
+		},
+		Match: func(e *sdkControlPolicy.ControlPolicy) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkControlPolicy.ControlPolicy) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.controlPolicy.ReadControlPolicy,
+		Update: func(ctx context.Context, id string, existing *sdkControlPolicy.ControlPolicy) error {
+			var state tfControlPolicy.ResourceModel
+			tfControlPolicy.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := tfControlPolicy.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.controlPolicy.UpdateControlPolicy(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -13,11 +14,11 @@ const (
 	tfControlPolicyResource   = "cm_control_policy"
 	ControlPolicyResourceName = "control_policy"
 
-	ControlPolicyName        = "tf control policy"
+	ControlPolicyName        = test_helpers.NamePrefix + "tf control policy"
 	ControlPolicyDescription = "test"
 	ControlPolicyType        = "aws_allowed_regions"
 
-	ControlPolicyNameAfterUpdate       = "updated tf control policy"
+	ControlPolicyNameAfterUpdate       = test_helpers.NamePrefix + "updated tf control policy"
 	ControlPolicyParametersAfterUpdate = "{\"regions\":[\"us-east-1\"]}"
 )
 
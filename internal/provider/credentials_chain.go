@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+)
+
+// credentialsChain is like credentials.ChainProvider, but reads MergeCredentialsChain from the feature flags of its
+// own Config instead of the package global flags, so provider aliases never affect each other.
+type credentialsChain struct {
+	providers []credentials.Provider
+	merge     bool
+}
+
+func newCredentialsChain(merge bool, providers ...credentials.Provider) *credentialsChain {
+	return &credentialsChain{providers: providers, merge: merge}
+}
+
+func (c *credentialsChain) Retrieve() (credentials.Value, error) {
+	var retVal credentials.Value
+	var errs []error
+
+	for _, p := range c.providers {
+		v, err := p.Retrieve()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if !c.merge {
+			return v, nil
+		}
+
+		retVal.Merge(v)
+		if retVal.IsComplete() {
+			return retVal, nil
+		}
+	}
+
+	if retVal.IsEmpty() {
+		err := credentials.ErrNoValidProvidersFoundInChain
+		if len(errs) > 0 {
+			err = errors.Join(errs...)
+		}
+
+		return credentials.Value{ProviderName: c.String()}, err
+	}
+
+	return retVal, nil
+}
+
+func (c *credentialsChain) String() string {
+	names := make([]string, len(c.providers))
+	for i, p := range c.providers {
+		names[i] = p.String()
+	}
+
+	return strings.Join(names, " ")
+}
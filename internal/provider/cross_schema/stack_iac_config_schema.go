@@ -2,7 +2,9 @@ package cross_schema
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	cm_stringvalidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -13,14 +15,23 @@ var IacConfigSchema = schema.SingleNestedAttribute{
 		"terraform_version": schema.StringAttribute{
 			MarkdownDescription: "the Terraform version that will be used for terraform operations.",
 			Optional:            true,
+			Validators: []validator.String{
+				cm_stringvalidators.IacVersion(),
+			},
 		},
 		"terragrunt_version": schema.StringAttribute{
 			MarkdownDescription: "the Terragrunt version that will be used for terragrunt operations.",
 			Optional:            true,
+			Validators: []validator.String{
+				cm_stringvalidators.IacVersion(),
+			},
 		},
 		"opentofu_version": schema.StringAttribute{
 			MarkdownDescription: "the OpenTofu version that will be used for tofu operations.",
 			Optional:            true,
+			Validators: []validator.String{
+				cm_stringvalidators.IacVersion(),
+			},
 		},
 		"is_terragrunt_run_all": schema.BoolAttribute{
 			MarkdownDescription: "When using terragrunt, as long as this field is set to `True`, this field will execute \"run-all\" commands on multiple modules for init/plan/apply",
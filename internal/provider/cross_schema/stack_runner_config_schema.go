@@ -5,6 +5,7 @@ import (
 
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	cm_objectvalidator "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/object"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,7 +27,9 @@ var StackRunnerConfigSchema = schema.SingleNestedAttribute{
 			MarkdownDescription: fmt.Sprintf("In case that `mode` is `%s`, groups must contain at least one runners group. If `mode` is `%s`, this field must not be configured.", cmTypes.SelfHosted, cmTypes.Managed),
 			ElementType:         types.StringType,
 			Optional:            true,
-			// Validation in ValidateConfig
 		},
 	},
+	Validators: []validator.Object{
+		cm_objectvalidator.RunnerConfig(),
+	},
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfCustomAbacConfiguration "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/custom_abac_configuration_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -69,6 +70,11 @@ func (r *CustomAbacConfigurationDataSource) Configure(_ context.Context, req dat
 
 // Read refreshes the Terraform state with the latest data.
 func (r *CustomAbacConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Get current state
 	var state tfCustomAbacConfiguration.ResourceModel
 
@@ -82,7 +88,7 @@ func (r *CustomAbacConfigurationDataSource) Read(ctx context.Context, req dataso
 	res, err := r.client.Client.customAbacConfiguration.ListCustomAbacConfigurations(ctx, customAbacConfigurationId, name)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read custom abac configuration"), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read custom abac configuration"), "", err)...)
 		return
 	} else if len(res) == 0 {
 		resp.Diagnostics.AddError(fmt.Sprintf(resourceNotFoundError), fmt.Sprintf(customAbacConfigurationNotFoundError))
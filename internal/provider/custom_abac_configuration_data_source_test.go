@@ -6,6 +6,7 @@ import (
 
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -37,7 +38,7 @@ variable "roles" {
 
 resource "cm_custom_abac_configuration" "custom_abac_configuration" {
   custom_abac_id = "xxxx"
-  name = "Custom ABAC Configuration"
+  name = "`+test_helpers.NamePrefix+`Custom ABAC Configuration"
   roles = var.roles
 
 }
@@ -52,16 +53,16 @@ data "cm_custom_abac_configuration" "custom_abac_configuration_data2" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_custom_abac_configuration.custom_abac_configuration_data", "id"),
-					resource.TestCheckResourceAttr("data.cm_custom_abac_configuration.custom_abac_configuration_data", "name", "Custom ABAC Configuration"),
+					resource.TestCheckResourceAttr("data.cm_custom_abac_configuration.custom_abac_configuration_data", "name", test_helpers.NamePrefix+"Custom ABAC Configuration"),
 					resource.TestCheckResourceAttrPair("data.cm_custom_abac_configuration.custom_abac_configuration_data", "id", "cm_custom_abac_configuration.custom_abac_configuration", "id"),
 					resource.TestCheckResourceAttrSet("data.cm_custom_abac_configuration.custom_abac_configuration_data2", "id"),
-					resource.TestCheckResourceAttr("data.cm_custom_abac_configuration.custom_abac_configuration_data2", "name", "Custom ABAC Configuration"),
+					resource.TestCheckResourceAttr("data.cm_custom_abac_configuration.custom_abac_configuration_data2", "name", test_helpers.NamePrefix+"Custom ABAC Configuration"),
 					resource.TestCheckResourceAttrPair("data.cm_custom_abac_configuration.custom_abac_configuration_data2", "id", "cm_custom_abac_configuration.custom_abac_configuration", "id"),
 				),
 			},
 			{
 				ConfigVariables: config.Variables{
-					"custom_abac_configuration_name": config.StringVariable("Custom ABAC Configuration"),
+					"custom_abac_configuration_name": config.StringVariable(test_helpers.NamePrefix + "Custom ABAC Configuration"),
 					"roles": config.ListVariable(config.ObjectVariable(map[string]config.Variable{
 						"org_id":   config.StringVariable(orgId),
 						"org_role": config.StringVariable(cmTypes.RoleViewer),
@@ -79,7 +80,7 @@ variable "roles" {
 
 resource "cm_custom_abac_configuration" "custom_abac_configuration" {
   custom_abac_id = "xxxx"
-  name = "Custom ABAC Configuration"
+  name = "`+test_helpers.NamePrefix+`Custom ABAC Configuration"
   roles = var.roles
 }
 
@@ -93,7 +94,7 @@ data "cm_custom_abac_configuration" "custom_abac_configuration_data" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_custom_abac_configuration.custom_abac_configuration_data", "id"),
-					resource.TestCheckResourceAttr("data.cm_custom_abac_configuration.custom_abac_configuration_data", "name", "Custom ABAC Configuration"),
+					resource.TestCheckResourceAttr("data.cm_custom_abac_configuration.custom_abac_configuration_data", "name", test_helpers.NamePrefix+"Custom ABAC Configuration"),
 					resource.TestCheckResourceAttrPair("data.cm_custom_abac_configuration.custom_abac_configuration_data", "id", "cm_custom_abac_configuration.custom_abac_configuration", "id"),
 				),
 			},
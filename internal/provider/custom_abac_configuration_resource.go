@@ -37,7 +37,7 @@ func (r *CustomAbacConfigurationResource) Metadata(_ context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + "_custom_abac_configuration"
 }
 
-func (r *CustomAbacConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CustomAbacConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys custom abac configurations.",
 		Attributes: map[string]schema.Attribute{
@@ -96,6 +96,9 @@ func (r *CustomAbacConfigurationResource) Schema(_ context.Context, _ resource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -150,6 +153,10 @@ func (r *CustomAbacConfigurationResource) ValidateConfig(ctx context.Context, re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *CustomAbacConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state tfCustomAbacConfiguration.ResourceModel
 
@@ -160,6 +167,12 @@ func (r *CustomAbacConfigurationResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.customAbacConfiguration.ReadCustomAbacConfiguration(ctx, id)
 
@@ -170,7 +183,7 @@ func (r *CustomAbacConfigurationResource) Read(ctx context.Context, req resource
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read custom abac configuration '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read custom abac configuration '%s'", id), "", err)...)
 		return
 	}
 
@@ -186,6 +199,12 @@ func (r *CustomAbacConfigurationResource) Read(ctx context.Context, req resource
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *CustomAbacConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfCustomAbacConfiguration.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -194,14 +213,17 @@ func (r *CustomAbacConfigurationResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := tfCustomAbacConfiguration.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.customAbacConfiguration.CreateCustomAbacConfiguration(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create custom abac configuration, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create custom abac configuration", err)...)
 		return
 	}
 
@@ -216,6 +238,12 @@ func (r *CustomAbacConfigurationResource) Create(ctx context.Context, req resour
 }
 
 func (r *CustomAbacConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfCustomAbacConfiguration.ResourceModel
 	var state tfCustomAbacConfiguration.ResourceModel
@@ -229,6 +257,12 @@ func (r *CustomAbacConfigurationResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfCustomAbacConfiguration.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -239,10 +273,7 @@ func (r *CustomAbacConfigurationResource) Update(ctx context.Context, req resour
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update custom abac configuration %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update custom abac configuration %s", id), err)...)
 		return
 	}
 
@@ -255,6 +286,12 @@ func (r *CustomAbacConfigurationResource) Update(ctx context.Context, req resour
 }
 
 func (r *CustomAbacConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfCustomAbacConfiguration.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -263,6 +300,12 @@ func (r *CustomAbacConfigurationResource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.customAbacConfiguration.DeleteCustomAbacConfiguration(ctx, id)
 
@@ -272,10 +315,7 @@ func (r *CustomAbacConfigurationResource) Delete(ctx context.Context, req resour
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete custom abac configuration %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete custom abac configuration %s", id), err)...)
 		return
 	}
 }
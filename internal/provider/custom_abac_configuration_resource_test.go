@@ -17,12 +17,12 @@ const (
 	customAbacConfigurationTfResourceName     = "custom_abac_configuration"
 
 	customAbacConfigurationAbacId = "abc-123"
-	customAbacConfigurationName   = "ABAC"
+	customAbacConfigurationName   = test_helpers.NamePrefix + "ABAC"
 
 	customAbacConfigurationOrgRole  = "member"
 	customAbacConfigurationOrgRole2 = "viewer"
 
-	customAbacConfigurationNameAfterUpdate = "updated name"
+	customAbacConfigurationNameAfterUpdate = test_helpers.NamePrefix + "updated name"
 )
 
 func TestAccCustomAbacConfigurationResourceResource(t *testing.T) {
@@ -35,11 +35,11 @@ func TestAccCustomAbacConfigurationResourceResource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-	name = "Developers"
+	name = "`+test_helpers.NamePrefix+`Developers"
 }
 
 resource "cm_team" "team2" {
-	name = "QA"
+	name = "`+test_helpers.NamePrefix+`QA"
 }
 
 resource "%s" "%s" {
@@ -70,11 +70,11 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-	name = "Developers"
+	name = "`+test_helpers.NamePrefix+`Developers"
 }
 
 resource "cm_team" "team2" {
-	name = "QA"
+	name = "`+test_helpers.NamePrefix+`QA"
 }
 
 resource "%s" "%s" {
@@ -95,11 +95,11 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-	name = "Developers"
+	name = "`+test_helpers.NamePrefix+`Developers"
 }
 
 resource "cm_team" "team2" {
-	name = "QA"
+	name = "`+test_helpers.NamePrefix+`QA"
 }
 
 resource "%s" "%s" {
@@ -133,11 +133,11 @@ resource "%s" "%s" {
 				},
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-	name = "Developers"
+	name = "`+test_helpers.NamePrefix+`Developers"
 }
 
 resource "cm_team" "team2" {
-	name = "QA"
+	name = "`+test_helpers.NamePrefix+`QA"
 }
 
 variable "org_id" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfCustomRole "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/custom_role_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -69,6 +70,11 @@ func (r *CustomRoleDataSource) Configure(_ context.Context, req datasource.Confi
 
 // Read refreshes the Terraform state with the latest data.
 func (r *CustomRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Get current state
 	var state tfCustomRole.ResourceModel
 
@@ -82,7 +88,7 @@ func (r *CustomRoleDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	res, err := r.client.Client.customRole.ListCustomRoles(ctx, customRoleId, name)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read custom role"), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read custom role"), "", err)...)
 		return
 	} else if len(res) == 0 {
 		resp.Diagnostics.AddError(fmt.Sprintf(resourceNotFoundError), fmt.Sprintf(customRoleNotFoundError))
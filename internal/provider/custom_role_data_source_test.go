@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -17,7 +18,7 @@ func TestAccCustomRoleDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_custom_role" "custom_role" {
-  name = "Custom Role"
+  name = "`+test_helpers.NamePrefix+`Custom Role"
 }
 
 data "cm_custom_role" "custom_role_data" {
@@ -30,20 +31,20 @@ data "cm_custom_role" "custom_role_data2" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_custom_role.custom_role_data", "id"),
-					resource.TestCheckResourceAttr("data.cm_custom_role.custom_role_data", "name", "Custom Role"),
+					resource.TestCheckResourceAttr("data.cm_custom_role.custom_role_data", "name", test_helpers.NamePrefix+"Custom Role"),
 					resource.TestCheckResourceAttrPair("data.cm_custom_role.custom_role_data", "id", "cm_custom_role.custom_role", "id"),
 					resource.TestCheckResourceAttrSet("data.cm_custom_role.custom_role_data2", "id"),
-					resource.TestCheckResourceAttr("data.cm_custom_role.custom_role_data2", "name", "Custom Role"),
+					resource.TestCheckResourceAttr("data.cm_custom_role.custom_role_data2", "name", test_helpers.NamePrefix+"Custom Role"),
 					resource.TestCheckResourceAttrPair("data.cm_custom_role.custom_role_data2", "id", "cm_custom_role.custom_role", "id"),
 				),
 			},
 			{
 				ConfigVariables: config.Variables{
-					"role_name": config.StringVariable("Custom Role"),
+					"role_name": config.StringVariable(test_helpers.NamePrefix + "Custom Role"),
 				},
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_custom_role" "custom_role" {
-  name = "Custom Role"
+  name = "`+test_helpers.NamePrefix+`Custom Role"
 }
 
 variable "role_name" {
@@ -56,7 +57,7 @@ data "cm_custom_role" "custom_role_data" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_custom_role.custom_role_data", "id"),
-					resource.TestCheckResourceAttr("data.cm_custom_role.custom_role_data", "name", "Custom Role"),
+					resource.TestCheckResourceAttr("data.cm_custom_role.custom_role_data", "name", test_helpers.NamePrefix+"Custom Role"),
 					resource.TestCheckResourceAttrPair("data.cm_custom_role.custom_role_data", "id", "cm_custom_role.custom_role", "id"),
 				),
 			},
@@ -5,10 +5,12 @@ import (
 	"fmt"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	sdkCustomRole "github.com/control-monkey/controlmonkey-sdk-go/services/custom_role"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfCustomRole "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/custom_role"
 	cm_stringvalidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +35,7 @@ func (r *CustomRoleResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_custom_role"
 }
 
-func (r *CustomRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CustomRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys custom roles.",
 		Attributes: map[string]schema.Attribute{
@@ -75,6 +77,10 @@ func (r *CustomRoleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "Restrict stack operations with supported types. Learn more [here](https://docs.controlmonkey.io/administration/users-and-roles/custom-roles). Find supported types [here](https://docs.controlmonkey.io/controlmonkey-api/api-enumerations#stack-restriction-types).",
 				Optional:            true,
 			},
+			adoptExistingAttributeName: adoptExistingAttribute("custom role", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -102,6 +108,10 @@ func (r *CustomRoleResource) Configure(_ context.Context, req resource.Configure
 
 // Read refreshes the Terraform state with the latest data.
 func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state tfCustomRole.ResourceModel
 
@@ -112,6 +122,12 @@ func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.customRole.ReadCustomRole(ctx, id)
 
@@ -122,7 +138,7 @@ func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read custom role '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read custom role '%s'", id), "", err)...)
 		return
 	}
 
@@ -138,6 +154,12 @@ func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfCustomRole.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -146,14 +168,31 @@ func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := tfCustomRole.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.customRole.CreateCustomRole(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create custom role, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create custom role", err)...)
 		return
 	}
 
@@ -168,6 +207,12 @@ func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfCustomRole.ResourceModel
 	var state tfCustomRole.ResourceModel
@@ -181,6 +226,12 @@ func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfCustomRole.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -191,10 +242,7 @@ func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update custom role %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update custom role %s", id), err)...)
 		return
 	}
 
@@ -207,6 +255,12 @@ func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfCustomRole.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -215,6 +269,12 @@ func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.customRole.DeleteCustomRole(ctx, id)
 
@@ -224,10 +284,7 @@ func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete custom role %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete custom role %s", id), err)...)
 		return
 	}
 }
@@ -235,3 +292,40 @@ func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing custom role with the planned name and applies the plan to it. It returns
+// false when there is no such custom role.
+func (r *CustomRoleResource) adoptExisting(ctx context.Context, plan *tfCustomRole.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkCustomRole.CustomRole]{
+		Kind: "custom role",
+		List: func(ctx context.Context) ([]*sdkCustomRole.CustomRole, error) {
+			return r.client.Client.customRole.ListCustomRoles(ctx, nil, plan.Name.ValueStringPointer())
+		},
+		Match: func(e *sdkCustomRole.CustomRole) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkCustomRole.CustomRole) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.customRole.ReadCustomRole,
+		Update: func(ctx context.Context, id string, existing *sdkCustomRole.CustomRole) error {
+			var state tfCustomRole.ResourceModel
+			tfCustomRole.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := tfCustomRole.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.customRole.UpdateCustomRole(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
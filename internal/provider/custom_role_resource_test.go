@@ -14,7 +14,7 @@ const (
 	tfCustomRoleResource     = "cm_custom_role"
 	customRoleTfResourceName = "custom_role"
 
-	customRoleName        = "Create Stack"
+	customRoleName        = test_helpers.NamePrefix + "Create Stack"
 	customRoleDescription = "test"
 
 	customRolePermission1 = "stack:create"
@@ -22,7 +22,7 @@ const (
 
 	customRoleStackRestriction = "restrictReadToOwnStacks"
 
-	customRoleNameAfterUpdate = "updated name"
+	customRoleNameAfterUpdate = test_helpers.NamePrefix + "updated name"
 )
 
 func TestAccCustomRoleResource(t *testing.T) {
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	sdkCustomRole "github.com/control-monkey/controlmonkey-sdk-go/services/custom_role"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	tfCustomRole "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/custom_role_data"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CustomRolesDataSource{}
+
+func NewCustomRolesDataSource() datasource.DataSource {
+	return &CustomRolesDataSource{}
+}
+
+type CustomRolesDataSource struct {
+	client *ControlMonkeyAPIClient
+}
+
+func (r *CustomRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_roles"
+}
+
+func (r *CustomRolesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nameFilterAttributes("custom roles")
+	attributes["custom_roles"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The custom roles matching the filters.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: resourceComputedAttributes(ctx, NewCustomRoleResource(), &resp.Diagnostics),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists custom roles, optionally filtered by name.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *CustomRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ControlMonkeyAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ControlMonkeyAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CustomRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//Get current state
+	var state tfCustomRole.ListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newNameFilter(state.NamePrefix, state.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Client.customRole.ListCustomRoles(ctx, nil, nil)
+	if err != nil {
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to list custom roles", "", err)...)
+		return
+	}
+
+	res = helpers.Filter(res, func(e *sdkCustomRole.CustomRole) bool { return filter.matches(e.Name) })
+
+	tfCustomRole.UpdateStateAfterList(res, &state)
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
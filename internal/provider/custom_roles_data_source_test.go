@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "cm_custom_role" "custom_role" {
+  name = "`+test_helpers.NamePrefix+`Custom Roles Data Source Unique"
+}
+data "cm_custom_roles" "custom_roles" {
+  name_prefix = "`+test_helpers.NamePrefix+`Custom Roles Data Source"
+
+  depends_on = [cm_custom_role.custom_role]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_custom_roles.custom_roles", "custom_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.cm_custom_roles.custom_roles", "custom_roles.0.name", test_helpers.NamePrefix+"Custom Roles Data Source Unique"),
+					resource.TestCheckResourceAttrPair("data.cm_custom_roles.custom_roles", "custom_roles.0.id", "cm_custom_role.custom_role", "id"),
+				),
+			},
+		},
+	})
+}
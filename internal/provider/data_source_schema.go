@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// resourceOnlyAttributes control how the resource is managed and are not part of the entity.
+var resourceOnlyAttributes = []string{adoptExistingAttributeName, deletionProtectionAttributeName}
+
+// resourceComputedAttributes returns the attributes of the resource schema as computed data source attributes, so
+// data sources returning full entities stay in sync with the matching resource.
+func resourceComputedAttributes(ctx context.Context, r resource.Resource, diagnostics *diag.Diagnostics) map[string]schema.Attribute {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	diagnostics.Append(resp.Diagnostics...)
+
+	retVal, err := computedAttributes(resp.Schema.Attributes)
+	if err != nil {
+		diagnostics.AddError("Unsupported Resource Schema", fmt.Sprintf("Failed to build the data source schema: %s. Please report this issue to the provider developers.", err))
+		return nil
+	}
+
+	for _, name := range resourceOnlyAttributes {
+		delete(retVal, name)
+	}
+
+	return retVal
+}
+
+func computedAttributes(attributes map[string]resourceSchema.Attribute) (map[string]schema.Attribute, error) {
+	retVal := make(map[string]schema.Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		computed, err := computedAttribute(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("attribute '%s': %w", name, err)
+		}
+
+		retVal[name] = computed
+	}
+
+	return retVal, nil
+}
+
+func computedAttribute(attribute resourceSchema.Attribute) (schema.Attribute, error) {
+	switch a := attribute.(type) {
+	case resourceSchema.StringAttribute:
+		return schema.StringAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.BoolAttribute:
+		return schema.BoolAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.Int64Attribute:
+		return schema.Int64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.Float64Attribute:
+		return schema.Float64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.ListAttribute:
+		return schema.ListAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.SetAttribute:
+		return schema.SetAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.MapAttribute:
+		return schema.MapAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.SingleNestedAttribute:
+		attributes, err := computedAttributes(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Attributes:          attributes,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.ListNestedAttribute:
+		attributes, err := computedAttributes(a.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return schema.ListNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	case resourceSchema.SetNestedAttribute:
+		attributes, err := computedAttributes(a.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return schema.SetNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported attribute type %T", attribute)
+}
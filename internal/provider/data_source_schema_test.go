@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourceComputedAttributesOfEveryResource(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cm"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			var diags diag.Diagnostics
+			attributes := resourceComputedAttributes(ctx, r, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			for _, name := range resourceOnlyAttributes {
+				if _, ok := attributes[name]; ok {
+					t.Errorf("expected attribute '%s' not to be part of the data source", name)
+				}
+			}
+			for name, attribute := range attributes {
+				if !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+					t.Errorf("expected attribute '%s' to be computed only", name)
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourceSchemasAreValid(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newDataSource := range New().DataSources(ctx) {
+		d := newDataSource()
+
+		var metadata datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "cm"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			var resp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+
+			if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Errorf("invalid schema: %v", diags)
+			}
+		})
+	}
+}
+
+func TestComputedAttributesUnsupportedType(t *testing.T) {
+	_, err := computedAttributes(map[string]resourceSchema.Attribute{
+		"nested": resourceSchema.SingleNestedAttribute{
+			Attributes: map[string]resourceSchema.Attribute{
+				"object": resourceSchema.ObjectAttribute{AttributeTypes: map[string]attr.Type{"name": types.StringType}},
+			},
+		},
+	})
+
+	if err == nil {
+		t.Fatalf("expected an error for an unsupported attribute type")
+	}
+	if expected := "attribute 'nested': attribute 'object': unsupported attribute type schema.ObjectAttribute"; err.Error() != expected {
+		t.Errorf("expected '%s', got '%s'", expected, err.Error())
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// clientAttributes are the attributes of the provider configuration the client is created with, with the diagnostic
+// shown while each is unknown.
+var clientAttributes = []struct {
+	name    string
+	summary string
+	detail  string
+}{
+	{
+		name:    "token",
+		summary: "Unknown ControlMonkey token",
+		detail:  fmt.Sprintf("The token is not known yet. Falling back to the %s environment variable could use the credentials of another organization, so the client is only created once the token is known.", credentials.EnvCredentialsVarToken),
+	},
+	{
+		name:    "endpoint",
+		summary: "Unknown ControlMonkey endpoint",
+		detail:  fmt.Sprintf("The endpoint is not known yet. Falling back to the %s environment variable could send the token to another API, so the client is only created once the endpoint is known.", EndpointEnvVar),
+	},
+	{
+		name:    "profile",
+		summary: "Unknown ControlMonkey profile",
+		detail:  "The profile is not known yet, the client is only created once it is known.",
+	},
+	{
+		name:    "shared_credentials_file",
+		summary: "Unknown ControlMonkey shared credentials file",
+		detail:  "The shared credentials file is not known yet, the client is only created once it is known.",
+	},
+}
+
+// isConfigured reports whether the API client was created. It is not while an attribute the client is created with is
+// unknown during plan, e.g. when the token is an output of another resource.
+func (c *ControlMonkeyAPIClient) isConfigured() bool {
+	return c != nil && c.Client != nil
+}
+
+// notConfiguredDiagnostics returns an error when the API client was not created, so a resource or data source stops
+// before sending a request with it. The error names the unknown attributes the client is waiting for.
+func (c *ControlMonkeyAPIClient) notConfiguredDiagnostics() diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if !c.isConfigured() {
+		if c != nil {
+			retVal.Append(c.unknownAttributes...)
+		}
+
+		retVal.AddError(
+			"Provider configuration is not known",
+			"The ControlMonkey client is created once the provider configuration is known, which may only happen during apply. If the provider configuration refers to other resources, apply them first, e.g. using -target.",
+		)
+	}
+
+	return retVal
+}
+
+// unknownClientAttributesDiagnostics returns an error for every unknown attribute the client is created with.
+func unknownClientAttributesDiagnostics(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	for _, a := range clientAttributes {
+		var value types.String
+		if diags := config.GetAttribute(ctx, path.Root(a.name), &value); diags.HasError() {
+			retVal.Append(diags...)
+			continue
+		}
+
+		if value.IsUnknown() {
+			retVal.AddAttributeError(path.Root(a.name), a.summary, a.detail)
+		}
+	}
+
+	return retVal
+}
+
+// withoutUnknownAttributes returns the configuration with every unknown value replaced by null, so attributes that are
+// not known yet are treated as not set, and a warning for every attribute that is not known. They take effect once they
+// are known, which may only happen during apply.
+func withoutUnknownAttributes(config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var retVal diag.Diagnostics
+
+	if config.Raw.IsFullyKnown() {
+		return config, retVal
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := config.Raw.As(&attributes); err != nil {
+		retVal.AddError("Invalid provider configuration", err.Error())
+		return config, retVal
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !attributes[name].IsFullyKnown() {
+			retVal.AddAttributeWarning(
+				path.Root(name),
+				"Unknown provider configuration",
+				fmt.Sprintf("%s is not known yet and is treated as not set until it is known, which may only happen during apply.", name),
+			)
+		}
+	}
+
+	raw, err := tftypes.Transform(config.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		retVal.AddError("Invalid provider configuration", err.Error())
+		return config, retVal
+	}
+	config.Raw = raw
+
+	return config, retVal
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigureWithUnknownCredentialsDefersClient(t *testing.T) {
+	for _, name := range []string{"token", "endpoint", "profile", "shared_credentials_file"} {
+		t.Run(name, func(t *testing.T) {
+			resp := testConfigureWithUnknownAttribute(t, name)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no errors, got %v", resp.Diagnostics)
+			}
+			if !testHasDiagnosticAt(resp.Diagnostics.Warnings(), path.Root(name)) {
+				t.Errorf("expected a warning on %s, got %v", name, resp.Diagnostics)
+			}
+
+			apiClient, ok := resp.ResourceData.(*ControlMonkeyAPIClient)
+			if !ok || apiClient.isConfigured() {
+				t.Fatalf("expected a deferred client, got %#v", resp.ResourceData)
+			}
+			if diags := apiClient.notConfiguredDiagnostics(); !testHasDiagnosticAt(diags.Errors(), path.Root(name)) {
+				t.Errorf("expected an error on %s for a deferred client, got %v", name, diags)
+			}
+		})
+	}
+}
+
+func TestConfigureWithUnknownOtherAttributeCreatesClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":{"items":[{}]}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv(credentials.EnvCredentialsVarToken, "token")
+	t.Setenv(EndpointEnvVar, server.URL)
+
+	for _, name := range []string{"adopt_existing", "allowed_namespace_ids", "minimum_iac_versions"} {
+		t.Run(name, func(t *testing.T) {
+			resp := testConfigureWithUnknownAttribute(t, name)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no errors, got %v", resp.Diagnostics)
+			}
+			if !testHasDiagnosticAt(resp.Diagnostics.Warnings(), path.Root(name)) {
+				t.Errorf("expected a warning on %s, got %v", name, resp.Diagnostics)
+			}
+
+			apiClient, ok := resp.ResourceData.(*ControlMonkeyAPIClient)
+			if !ok || !apiClient.isConfigured() {
+				t.Fatalf("expected a client, got %#v", resp.ResourceData)
+			}
+			if apiClient.AdoptExisting || apiClient.hasNamespaceRestrictions() || len(apiClient.MinimumIacVersions) > 0 {
+				t.Errorf("expected the unknown %s to be treated as not set, got %#v", name, apiClient)
+			}
+		})
+	}
+}
+
+func testConfigureWithUnknownAttribute(t *testing.T, name string) *provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for attributeName, attributeType := range objectType.AttributeTypes {
+		values[attributeName] = tftypes.NewValue(attributeType, nil)
+	}
+	values[name] = tftypes.NewValue(objectType.AttributeTypes[name], tftypes.UnknownValue)
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := new(provider.ConfigureResponse)
+	p.Configure(ctx, req, resp)
+
+	return resp
+}
+
+func testHasDiagnosticAt(diags diag.Diagnostics, p path.Path) bool {
+	for _, d := range diags {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(p) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	deletionProtectionAttributeName = "deletion_protection"
+	deletionProtectionError         = "Deletion protection is enabled"
+)
+
+func deletionProtectionAttribute(entity string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("When enabled, destroying or replacing the %s fails. It must be disabled in a separate apply before the %s can be destroyed or replaced. Defaults to `false`.", entity, entity),
+		Optional:            true,
+	}
+}
+
+// deletionProtectionPlanDiagnostics fails destroy and replace plans of a resource whose current state has
+// deletion_protection enabled. The state is used, so disabling the protection in the same apply has no effect.
+// replacingAttributes are the attributes with a RequiresReplace plan modifier: the replacements they require are not
+// in the response of ModifyPlan, so a change of their value is detected here.
+func deletionProtectionPlanDiagnostics(ctx context.Context, entity string, req resource.ModifyPlanRequest, replacingAttributes ...path.Path) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if req.State.Raw.IsNull() {
+		return retVal
+	}
+
+	var enabled types.Bool
+	if diags := req.State.GetAttribute(ctx, path.Root(deletionProtectionAttributeName), &enabled); diags.HasError() || !enabled.ValueBool() {
+		return retVal
+	}
+
+	if req.Plan.Raw.IsNull() {
+		retVal.AddError(
+			deletionProtectionError,
+			fmt.Sprintf("Cannot destroy the %s while %s is true. Set it to false and apply before destroying the %s.", entity, deletionProtectionAttributeName, entity),
+		)
+		return retVal
+	}
+
+	for _, p := range replacingAttributes {
+		var stateValue, planValue attr.Value
+		retVal.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		retVal.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		if retVal.HasError() {
+			return retVal
+		}
+
+		if !planValue.Equal(stateValue) {
+			retVal.AddAttributeError(
+				p,
+				deletionProtectionError,
+				fmt.Sprintf("Cannot replace the %s while %s is true, the change of %s requires a replacement. Set %s to false and apply before making this change.", entity, deletionProtectionAttributeName, p, deletionProtectionAttributeName),
+			)
+		}
+	}
+
+	return retVal
+}
+
+// deletionProtectionDeleteDiagnostics is checked by Delete as well, in case the plan was not created by this provider.
+func deletionProtectionDeleteDiagnostics(entity string, id string, enabled types.Bool) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if enabled.ValueBool() {
+		retVal.AddError(
+			deletionProtectionError,
+			fmt.Sprintf("Cannot delete %s '%s' while %s is true.", entity, id, deletionProtectionAttributeName),
+		)
+	}
+
+	return retVal
+}
@@ -36,7 +36,7 @@ func (r *DisasterRecoveryConfigurationResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_disaster_recovery_configuration"
 }
 
-func (r *DisasterRecoveryConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DisasterRecoveryConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys disaster recovery configurations. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/disaster-recovery)",
 		Attributes: map[string]schema.Attribute{
@@ -102,6 +102,9 @@ func (r *DisasterRecoveryConfigurationResource) Schema(_ context.Context, _ reso
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -128,6 +131,10 @@ func (r *DisasterRecoveryConfigurationResource) Configure(_ context.Context, req
 
 // Read refreshes the Terraform state with the latest data.
 func (r *DisasterRecoveryConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state tfDisasterRecoveryConfiguration.ResourceModel
 
@@ -138,6 +145,12 @@ func (r *DisasterRecoveryConfigurationResource) Read(ctx context.Context, req re
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.disasterRecovery.ReadDisasterRecoveryConfiguration(ctx, id)
 
@@ -148,7 +161,7 @@ func (r *DisasterRecoveryConfigurationResource) Read(ctx context.Context, req re
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read disaster recovery configuration '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read disaster recovery configuration '%s'", id), "", err)...)
 		return
 	}
 
@@ -164,6 +177,12 @@ func (r *DisasterRecoveryConfigurationResource) Read(ctx context.Context, req re
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *DisasterRecoveryConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfDisasterRecoveryConfiguration.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -172,14 +191,17 @@ func (r *DisasterRecoveryConfigurationResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := tfDisasterRecoveryConfiguration.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.disasterRecovery.CreateDisasterRecoveryConfiguration(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create disaster recovery configuration, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create disaster recovery configuration", err)...)
 		return
 	}
 
@@ -194,6 +216,12 @@ func (r *DisasterRecoveryConfigurationResource) Create(ctx context.Context, req
 }
 
 func (r *DisasterRecoveryConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfDisasterRecoveryConfiguration.ResourceModel
 	var state tfDisasterRecoveryConfiguration.ResourceModel
@@ -207,6 +235,12 @@ func (r *DisasterRecoveryConfigurationResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfDisasterRecoveryConfiguration.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -217,10 +251,7 @@ func (r *DisasterRecoveryConfigurationResource) Update(ctx context.Context, req
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update disaster recovery configuration %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update disaster recovery configuration %s", id), err)...)
 		return
 	}
 
@@ -233,6 +264,12 @@ func (r *DisasterRecoveryConfigurationResource) Update(ctx context.Context, req
 }
 
 func (r *DisasterRecoveryConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfDisasterRecoveryConfiguration.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -241,6 +278,12 @@ func (r *DisasterRecoveryConfigurationResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.disasterRecovery.DeleteDisasterRecoveryConfiguration(ctx, id)
 
@@ -250,10 +293,7 @@ func (r *DisasterRecoveryConfigurationResource) Delete(ctx context.Context, req
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete disaster recovery configuration %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete disaster recovery configuration %s", id), err)...)
 		return
 	}
 }
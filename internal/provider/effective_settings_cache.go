@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/organization"
+)
+
+// effectiveSettingsCache holds the namespaces and the org configuration that the effective settings of stacks are
+// resolved against. Each is read once per provider instance, so refreshing many stacks doesn't read them again for
+// every stack. The resources that change them forget the cached value.
+type effectiveSettingsCache struct {
+	mu                   sync.Mutex
+	namespaceReads       map[string]*cachedRead[*namespace.Namespace]
+	orgConfigurationRead *cachedRead[*organization.OrgConfiguration]
+}
+
+// cachedRead is the result of a read that is sent once.
+type cachedRead[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+func newEffectiveSettingsCache() *effectiveSettingsCache {
+	return &effectiveSettingsCache{
+		namespaceReads:       make(map[string]*cachedRead[*namespace.Namespace]),
+		orgConfigurationRead: new(cachedRead[*organization.OrgConfiguration]),
+	}
+}
+
+// namespace returns the namespace with the given id, and whether this call read it. A failed read is cached as well,
+// so its error is reported once.
+func (c *effectiveSettingsCache) namespace(ctx context.Context, client *Client, id string) (*namespace.Namespace, bool, error) {
+	c.mu.Lock()
+	read, ok := c.namespaceReads[id]
+	if !ok {
+		read = new(cachedRead[*namespace.Namespace])
+		c.namespaceReads[id] = read
+	}
+	c.mu.Unlock()
+
+	return read.get(func() (*namespace.Namespace, error) {
+		return client.namespace.ReadNamespace(ctx, id)
+	})
+}
+
+// orgConfiguration returns the org configuration, and whether this call read it. A failed read is cached as well, so
+// its error is reported once.
+func (c *effectiveSettingsCache) orgConfiguration(ctx context.Context, client *Client) (*organization.OrgConfiguration, bool, error) {
+	c.mu.Lock()
+	read := c.orgConfigurationRead
+	c.mu.Unlock()
+
+	return read.get(func() (*organization.OrgConfiguration, error) {
+		return client.organization.ReadOrgConfiguration(ctx)
+	})
+}
+
+func (c *effectiveSettingsCache) forgetNamespace(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.namespaceReads, id)
+}
+
+func (c *effectiveSettingsCache) forgetOrgConfiguration() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.orgConfigurationRead = new(cachedRead[*organization.OrgConfiguration])
+}
+
+func (r *cachedRead[T]) get(read func() (T, error)) (T, bool, error) {
+	first := false
+	r.once.Do(func() {
+		r.value, r.err = read()
+		first = true
+	})
+
+	return r.value, first, r.err
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestEffectiveSettingsCache(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/org/configuration" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"response":{"errors":[{"code":"forbidden","message":"not permitted"}]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"response":{"items":[{"id":"ns-1","name":"namespace"}]}}`))
+	}))
+	defer server.Close()
+
+	client, diags := (&Config{Token: "token", Endpoint: server.URL}).Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	ctx := context.Background()
+	cache := newEffectiveSettingsCache()
+
+	var wg sync.WaitGroup
+	firsts := make(chan bool, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ns, first, err := cache.namespace(ctx, client, "ns-1")
+			if err != nil || ns == nil || *ns.ID != "ns-1" {
+				t.Errorf("unexpected namespace %v, error %v", ns, err)
+			}
+			firsts <- first
+		}()
+	}
+	wg.Wait()
+	close(firsts)
+
+	readers := 0
+	for first := range firsts {
+		if first {
+			readers++
+		}
+	}
+	if readers != 1 || requests["/namespace/ns-1"] != 1 {
+		t.Errorf("expected the namespace to be read once, got %d readers and %d requests", readers, requests["/namespace/ns-1"])
+	}
+
+	for i, expectedFirst := range []bool{true, false} {
+		if _, first, err := cache.orgConfiguration(ctx, client); err == nil || first != expectedFirst {
+			t.Errorf("read %d of the org configuration: expected an error and first %t, got %v and %t", i, expectedFirst, err, first)
+		}
+	}
+	if requests["/org/configuration"] != 1 {
+		t.Errorf("expected the failed read of the org configuration to be cached, got %d requests", requests["/org/configuration"])
+	}
+
+	cache.forgetNamespace("ns-1")
+	cache.forgetOrgConfiguration()
+	_, _, _ = cache.namespace(ctx, client, "ns-1")
+	_, _, _ = cache.orgConfiguration(ctx, client)
+
+	if requests["/namespace/ns-1"] != 2 || requests["/org/configuration"] != 2 {
+		t.Errorf("expected forgotten values to be read again, got %v", requests)
+	}
+}
@@ -2,6 +2,7 @@ package blueprint
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -15,6 +16,9 @@ type ResourceModel struct {
 	SkipPlanOnStackInitialization    types.Bool                  `tfsdk:"skip_plan_on_stack_initialization"`
 	AutoApproveApplyOnInitialization types.Bool                  `tfsdk:"auto_approve_apply_on_initialization"`
 	Policy                           *PolicyModel                `tfsdk:"policy"`
+	AdoptExisting                    types.Bool                  `tfsdk:"adopt_existing"`
+	DeletionProtection               types.Bool                  `tfsdk:"deletion_protection"`
+	Timeouts                         timeouts.Value              `tfsdk:"timeouts"`
 }
 
 type VcsInfoModel struct {
@@ -1,6 +1,7 @@
 package blueprint_data
 
 import (
+	tfBlueprint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,3 +9,22 @@ type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
+
+// ItemModel describes a single entity returned by the plural data source.
+type ItemModel struct {
+	ID                               types.String                            `tfsdk:"id"`
+	Name                             types.String                            `tfsdk:"name"`
+	Description                      types.String                            `tfsdk:"description"`
+	BlueprintVcsInfo                 *tfBlueprint.VcsInfoModel               `tfsdk:"blueprint_vcs_info"`
+	StackConfiguration               *tfBlueprint.StackConfigurationModel    `tfsdk:"stack_configuration"`
+	SubstituteParameters             []*tfBlueprint.SubstituteParameterModel `tfsdk:"substitute_parameters"`
+	SkipPlanOnStackInitialization    types.Bool                              `tfsdk:"skip_plan_on_stack_initialization"`
+	AutoApproveApplyOnInitialization types.Bool                              `tfsdk:"auto_approve_apply_on_initialization"`
+	Policy                           *tfBlueprint.PolicyModel                `tfsdk:"policy"`
+}
+
+type ListModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Blueprints []*ItemModel `tfsdk:"blueprints"`
+}
@@ -3,6 +3,7 @@ package blueprint_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkBlueprint "github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
+	tfBlueprint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/blueprint"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,35 @@ func UpdateStateAfterRead(apiEntity *sdkBlueprint.Blueprint, state *ResourceMode
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkBlueprint.Blueprint, state *ListModel) {
+	state.Blueprints = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.Blueprints = append(state.Blueprints, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkBlueprint.Blueprint) ItemModel {
+	var retVal ItemModel
+	var entity tfBlueprint.ResourceModel
+
+	tfBlueprint.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.Description = entity.Description
+	retVal.BlueprintVcsInfo = entity.BlueprintVcsInfo
+	retVal.StackConfiguration = entity.StackConfiguration
+	retVal.SubstituteParameters = entity.SubstituteParameters
+	retVal.SkipPlanOnStackInitialization = entity.SkipPlanOnStackInitialization
+	retVal.AutoApproveApplyOnInitialization = entity.AutoApproveApplyOnInitialization
+	retVal.Policy = entity.Policy
+
+	return retVal
+}
+
+//endregion
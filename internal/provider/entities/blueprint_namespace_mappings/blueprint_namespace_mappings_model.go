@@ -2,6 +2,7 @@ package blueprint_namespace_mappings
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -9,6 +10,7 @@ type ResourceModel struct {
 	ID          types.String      `tfsdk:"id"`
 	BlueprintId types.String      `tfsdk:"blueprint_id"`
 	Namespaces  []*NamespaceModel `tfsdk:"namespaces"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
 }
 
 type NamespaceModel struct { //When new field is added consider Hash() function
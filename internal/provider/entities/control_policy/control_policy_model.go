@@ -2,13 +2,16 @@ package controlPolicy
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceModel struct {
-	ID          types.String         `tfsdk:"id"`
-	Name        types.String         `tfsdk:"name"`
-	Description types.String         `tfsdk:"description"`
-	Type        types.String         `tfsdk:"type"`
-	Parameters  jsontypes.Normalized `tfsdk:"parameters"`
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	Description   types.String         `tfsdk:"description"`
+	Type          types.String         `tfsdk:"type"`
+	Parameters    jsontypes.Normalized `tfsdk:"parameters"`
+	AdoptExisting types.Bool           `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value       `tfsdk:"timeouts"`
 }
@@ -1,6 +1,7 @@
 package control_policy_data
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,3 +9,18 @@ type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
+
+// ItemModel describes a single entity returned by the plural data source.
+type ItemModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Type        types.String         `tfsdk:"type"`
+	Parameters  jsontypes.Normalized `tfsdk:"parameters"`
+}
+
+type ListModel struct {
+	NamePrefix      types.String `tfsdk:"name_prefix"`
+	NameRegex       types.String `tfsdk:"name_regex"`
+	ControlPolicies []*ItemModel `tfsdk:"control_policies"`
+}
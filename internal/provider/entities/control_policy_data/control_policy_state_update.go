@@ -3,6 +3,7 @@ package control_policy_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkControlPolicy "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
+	tfControlPolicy "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,31 @@ func UpdateStateAfterRead(apiEntity *sdkControlPolicy.ControlPolicy, state *Reso
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkControlPolicy.ControlPolicy, state *ListModel) {
+	state.ControlPolicies = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.ControlPolicies = append(state.ControlPolicies, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkControlPolicy.ControlPolicy) ItemModel {
+	var retVal ItemModel
+	var entity tfControlPolicy.ResourceModel
+
+	tfControlPolicy.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.Description = entity.Description
+	retVal.Type = entity.Type
+	retVal.Parameters = entity.Parameters
+
+	return retVal
+}
+
+//endregion
@@ -1,6 +1,7 @@
 package controlPolicyGroup
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -9,6 +10,8 @@ type ResourceModel struct {
 	Name            types.String          `tfsdk:"name"`
 	Description     types.String          `tfsdk:"description"`
 	ControlPolicies []*ControlPolicyModel `tfsdk:"control_policies"`
+	AdoptExisting   types.Bool            `tfsdk:"adopt_existing"`
+	Timeouts        timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type ControlPolicyModel struct {
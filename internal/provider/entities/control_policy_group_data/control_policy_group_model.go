@@ -1,6 +1,7 @@
 package control_policy_group_data
 
 import (
+	tfControlPolicyGroup "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_group"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,3 +9,17 @@ type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
+
+// ItemModel describes a single entity returned by the plural data source.
+type ItemModel struct {
+	ID              types.String                               `tfsdk:"id"`
+	Name            types.String                               `tfsdk:"name"`
+	Description     types.String                               `tfsdk:"description"`
+	ControlPolicies []*tfControlPolicyGroup.ControlPolicyModel `tfsdk:"control_policies"`
+}
+
+type ListModel struct {
+	NamePrefix          types.String `tfsdk:"name_prefix"`
+	NameRegex           types.String `tfsdk:"name_regex"`
+	ControlPolicyGroups []*ItemModel `tfsdk:"control_policy_groups"`
+}
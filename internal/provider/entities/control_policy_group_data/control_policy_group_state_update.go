@@ -3,6 +3,7 @@ package control_policy_group_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkControlPolicyGroup "github.com/control-monkey/controlmonkey-sdk-go/services/control_policy_group"
+	tfControlPolicyGroup "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/control_policy_group"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,30 @@ func UpdateStateAfterRead(apiEntity *sdkControlPolicyGroup.ControlPolicyGroup, s
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkControlPolicyGroup.ControlPolicyGroup, state *ListModel) {
+	state.ControlPolicyGroups = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.ControlPolicyGroups = append(state.ControlPolicyGroups, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkControlPolicyGroup.ControlPolicyGroup) ItemModel {
+	var retVal ItemModel
+	var entity tfControlPolicyGroup.ResourceModel
+
+	tfControlPolicyGroup.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.Description = entity.Description
+	retVal.ControlPolicies = entity.ControlPolicies
+
+	return retVal
+}
+
+//endregion
@@ -2,6 +2,7 @@ package controlPolicyGroupMappings
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"strings"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
@@ -12,6 +13,7 @@ type ResourceModel struct {
 	ID                   types.String   `tfsdk:"id"`
 	ControlPolicyGroupId types.String   `tfsdk:"control_policy_group_id"`
 	Targets              []*TargetModel `tfsdk:"targets"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 type TargetModel struct {
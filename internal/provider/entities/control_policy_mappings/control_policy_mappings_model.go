@@ -2,6 +2,7 @@ package controlPolicyMappings
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"strings"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
@@ -12,6 +13,7 @@ type ResourceModel struct {
 	ID              types.String   `tfsdk:"id"`
 	ControlPolicyId types.String   `tfsdk:"control_policy_id"`
 	Targets         []*TargetModel `tfsdk:"targets"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type TargetModel struct {
@@ -1,14 +1,16 @@
 package customAbacConfiguration
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	CustomAbacId types.String `tfsdk:"custom_abac_id"`
-	Name         types.String `tfsdk:"name"`
-	Roles        []*RoleModel `tfsdk:"roles"`
+	ID           types.String   `tfsdk:"id"`
+	CustomAbacId types.String   `tfsdk:"custom_abac_id"`
+	Name         types.String   `tfsdk:"name"`
+	Roles        []*RoleModel   `tfsdk:"roles"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type RoleModel struct {
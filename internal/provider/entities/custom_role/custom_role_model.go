@@ -1,6 +1,7 @@
 package customRole
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -10,6 +11,8 @@ type ResourceModel struct {
 	Description      types.String       `tfsdk:"description"`
 	Permissions      []*PermissionModel `tfsdk:"permissions"`
 	StackRestriction types.String       `tfsdk:"stack_restriction"`
+	AdoptExisting    types.Bool         `tfsdk:"adopt_existing"`
+	Timeouts         timeouts.Value     `tfsdk:"timeouts"`
 }
 
 type PermissionModel struct {
@@ -1,6 +1,7 @@
 package custom_role_data
 
 import (
+	tfCustomRole "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/custom_role"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,3 +9,18 @@ type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
+
+// ItemModel describes a single entity returned by the plural data source.
+type ItemModel struct {
+	ID               types.String                    `tfsdk:"id"`
+	Name             types.String                    `tfsdk:"name"`
+	Description      types.String                    `tfsdk:"description"`
+	Permissions      []*tfCustomRole.PermissionModel `tfsdk:"permissions"`
+	StackRestriction types.String                    `tfsdk:"stack_restriction"`
+}
+
+type ListModel struct {
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	CustomRoles []*ItemModel `tfsdk:"custom_roles"`
+}
@@ -3,6 +3,7 @@ package custom_role_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkCustomRole "github.com/control-monkey/controlmonkey-sdk-go/services/custom_role"
+	tfCustomRole "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/custom_role"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,31 @@ func UpdateStateAfterRead(apiEntity *sdkCustomRole.CustomRole, state *ResourceMo
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkCustomRole.CustomRole, state *ListModel) {
+	state.CustomRoles = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.CustomRoles = append(state.CustomRoles, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkCustomRole.CustomRole) ItemModel {
+	var retVal ItemModel
+	var entity tfCustomRole.ResourceModel
+
+	tfCustomRole.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.Description = entity.Description
+	retVal.Permissions = entity.Permissions
+	retVal.StackRestriction = entity.StackRestriction
+
+	return retVal
+}
+
+//endregion
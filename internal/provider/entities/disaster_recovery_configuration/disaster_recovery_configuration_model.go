@@ -2,6 +2,7 @@ package disaster_recovery_configuration
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -10,6 +11,7 @@ type ResourceModel struct {
 	Scope          types.String         `tfsdk:"scope"`
 	CloudAccountId types.String         `tfsdk:"cloud_account_id"`
 	BackupStrategy *BackupStrategyModel `tfsdk:"backup_strategy"`
+	Timeouts       timeouts.Value       `tfsdk:"timeouts"`
 }
 
 type BackupStrategyModel struct {
@@ -2,6 +2,7 @@ package events_subscriptions
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"strings"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
@@ -13,6 +14,7 @@ type ResourceModel struct {
 	Scope         types.String         `tfsdk:"scope"`
 	ScopeId       types.String         `tfsdk:"scope_id"`
 	Subscriptions []*SubscriptionModel `tfsdk:"subscriptions"`
+	Timeouts      timeouts.Value       `tfsdk:"timeouts"`
 }
 
 type SubscriptionModel struct { //When new field is added consider Hash() function
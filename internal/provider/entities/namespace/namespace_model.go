@@ -2,6 +2,7 @@ package namespace
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -14,6 +15,9 @@ type ResourceModel struct {
 	RunnerConfig             *RunnerConfigModel             `tfsdk:"runner_config"`
 	DeploymentApprovalPolicy *DeploymentApprovalPolicyModel `tfsdk:"deployment_approval_policy"`
 	Capabilities             *CapabilitiesModel             `tfsdk:"capabilities"`
+	AdoptExisting            types.Bool                     `tfsdk:"adopt_existing"`
+	DeletionProtection       types.Bool                     `tfsdk:"deletion_protection"`
+	Timeouts                 timeouts.Value                 `tfsdk:"timeouts"`
 }
 
 type ExternalCredentialsModel struct {
@@ -1,10 +1,23 @@
 package namespace_data
 
 import (
+	tfNamespace "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/namespace"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID                       types.String                               `tfsdk:"id"`
+	Name                     types.String                               `tfsdk:"name"`
+	Description              types.String                               `tfsdk:"description"`
+	ExternalCredentials      []*tfNamespace.ExternalCredentialsModel    `tfsdk:"external_credentials"`
+	IacConfig                *tfNamespace.IacConfigModel                `tfsdk:"iac_config"`
+	RunnerConfig             *tfNamespace.RunnerConfigModel             `tfsdk:"runner_config"`
+	DeploymentApprovalPolicy *tfNamespace.DeploymentApprovalPolicyModel `tfsdk:"deployment_approval_policy"`
+	Capabilities             *tfNamespace.CapabilitiesModel             `tfsdk:"capabilities"`
+}
+
+type ListModel struct {
+	NamePrefix types.String     `tfsdk:"name_prefix"`
+	NameRegex  types.String     `tfsdk:"name_regex"`
+	Namespaces []*ResourceModel `tfsdk:"namespaces"`
 }
@@ -3,11 +3,31 @@ package namespace_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkNamespace "github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	tfNamespace "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/namespace"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func UpdateStateAfterRead(apiEntity *sdkNamespace.Namespace, state *ResourceModel, diagnostics *diag.Diagnostics) {
+	var namespace tfNamespace.ResourceModel
+	tfNamespace.UpdateStateAfterRead(apiEntity, &namespace)
+
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
+	state.Description = namespace.Description
+	state.ExternalCredentials = namespace.ExternalCredentials
+	state.IacConfig = namespace.IacConfig
+	state.RunnerConfig = namespace.RunnerConfig
+	state.DeploymentApprovalPolicy = namespace.DeploymentApprovalPolicy
+	state.Capabilities = namespace.Capabilities
+}
+
+func UpdateStateAfterList(apiEntities []*sdkNamespace.Namespace, state *ListModel, diagnostics *diag.Diagnostics) {
+	state.Namespaces = make([]*ResourceModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		var item ResourceModel
+		UpdateStateAfterRead(apiEntity, &item, diagnostics)
+		state.Namespaces = append(state.Namespaces, &item)
+	}
 }
@@ -2,6 +2,7 @@ package namespace_permissions
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"strings"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
@@ -12,6 +13,7 @@ type ResourceModel struct {
 	ID          types.String        `tfsdk:"id"`
 	NamespaceId types.String        `tfsdk:"namespace_id"`
 	Permissions []*PermissionsModel `tfsdk:"permissions"`
+	Timeouts    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type PermissionsModel struct { //When new field is added consider Hash() function
@@ -1,6 +1,9 @@
 package notfication_endpoint
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type ResourceModel struct {
 	ID             types.String         `tfsdk:"id"`
@@ -9,6 +12,8 @@ type ResourceModel struct {
 	Url            types.String         `tfsdk:"url"`
 	SlackAppConfig *SlackAppConfigModel `tfsdk:"slack_app_config"`
 	EmailAddresses types.List           `tfsdk:"email_addresses"`
+	AdoptExisting  types.Bool           `tfsdk:"adopt_existing"`
+	Timeouts       timeouts.Value       `tfsdk:"timeouts"`
 }
 
 type SlackAppConfigModel struct {
@@ -1,6 +1,7 @@
 package notification_endpoint_data
 
 import (
+	tfNotificationEndpoint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/notification_endpoint"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,3 +9,19 @@ type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
+
+// ItemModel describes a single entity returned by the plural data source.
+type ItemModel struct {
+	ID             types.String                                `tfsdk:"id"`
+	Name           types.String                                `tfsdk:"name"`
+	Protocol       types.String                                `tfsdk:"protocol"`
+	Url            types.String                                `tfsdk:"url"`
+	SlackAppConfig *tfNotificationEndpoint.SlackAppConfigModel `tfsdk:"slack_app_config"`
+	EmailAddresses types.List                                  `tfsdk:"email_addresses"`
+}
+
+type ListModel struct {
+	NamePrefix            types.String `tfsdk:"name_prefix"`
+	NameRegex             types.String `tfsdk:"name_regex"`
+	NotificationEndpoints []*ItemModel `tfsdk:"notification_endpoints"`
+}
@@ -3,6 +3,7 @@ package notification_endpoint_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkNotification "github.com/control-monkey/controlmonkey-sdk-go/services/notification"
+	tfNotificationEndpoint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/notification_endpoint"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,32 @@ func UpdateStateAfterRead(apiEntity *sdkNotification.Endpoint, state *ResourceMo
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkNotification.Endpoint, state *ListModel) {
+	state.NotificationEndpoints = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.NotificationEndpoints = append(state.NotificationEndpoints, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkNotification.Endpoint) ItemModel {
+	var retVal ItemModel
+	var entity tfNotificationEndpoint.ResourceModel
+
+	tfNotificationEndpoint.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.Protocol = entity.Protocol
+	retVal.Url = entity.Url
+	retVal.SlackAppConfig = entity.SlackAppConfig
+	retVal.EmailAddresses = entity.EmailAddresses
+
+	return retVal
+}
+
+//endregion
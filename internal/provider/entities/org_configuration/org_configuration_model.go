@@ -1,6 +1,7 @@
 package organization
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,6 +18,8 @@ type ResourceModel struct {
 	RunnerConfig             *RunnerConfigModel              `tfsdk:"runner_config"`
 	SuppressedResources      *SuppressedResourcesModel       `tfsdk:"suppressed_resources"`
 	ReportConfigurations     []*ReportConfigurationModel     `tfsdk:"report_configurations"`
+	AdoptExisting            types.Bool                      `tfsdk:"adopt_existing"`
+	Timeouts                 timeouts.Value                  `tfsdk:"timeouts"`
 }
 
 type IacConfigModel struct {
@@ -0,0 +1,17 @@
+package org_configuration_data
+
+import (
+	tfOrgConfiguration "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/org_configuration"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceModel struct {
+	ID                       types.String                                       `tfsdk:"id"`
+	IacConfig                *tfOrgConfiguration.IacConfigModel                 `tfsdk:"iac_config"`
+	S3StateFilesLocations    []*tfOrgConfiguration.S3StateFilesLocationModel    `tfsdk:"s3_state_files_locations"`
+	AzureStateFilesLocations []*tfOrgConfiguration.AzureStateFilesLocationModel `tfsdk:"azure_storage_state_files_locations"`
+	GcsStateFilesLocations   []*tfOrgConfiguration.GcsStateFilesLocationModel   `tfsdk:"gcs_state_files_locations"`
+	RunnerConfig             *tfOrgConfiguration.RunnerConfigModel              `tfsdk:"runner_config"`
+	SuppressedResources      *tfOrgConfiguration.SuppressedResourcesModel       `tfsdk:"suppressed_resources"`
+	ReportConfigurations     []*tfOrgConfiguration.ReportConfigurationModel     `tfsdk:"report_configurations"`
+}
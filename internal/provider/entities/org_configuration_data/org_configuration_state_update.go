@@ -0,0 +1,21 @@
+package org_configuration_data
+
+import (
+	sdkOrganization "github.com/control-monkey/controlmonkey-sdk-go/services/organization"
+	tfOrgConfiguration "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/org_configuration"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func UpdateStateAfterRead(apiEntity *sdkOrganization.OrgConfiguration, state *ResourceModel) {
+	var orgConfiguration tfOrgConfiguration.ResourceModel
+	tfOrgConfiguration.UpdateStateAfterRead(apiEntity, &orgConfiguration)
+
+	state.ID = types.StringValue(tfOrgConfiguration.ImportID)
+	state.IacConfig = orgConfiguration.IacConfig
+	state.S3StateFilesLocations = orgConfiguration.S3StateFilesLocations
+	state.AzureStateFilesLocations = orgConfiguration.AzureStateFilesLocations
+	state.GcsStateFilesLocations = orgConfiguration.GcsStateFilesLocations
+	state.RunnerConfig = orgConfiguration.RunnerConfig
+	state.SuppressedResources = orgConfiguration.SuppressedResources
+	state.ReportConfigurations = orgConfiguration.ReportConfigurations
+}
@@ -0,0 +1,261 @@
+package stack
+
+import (
+	"context"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkNamespace "github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	sdkOrganization "github.com/control-monkey/controlmonkey-sdk-go/services/organization"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	EffectiveSourceStack     = "stack"
+	EffectiveSourceNamespace = "namespace"
+	EffectiveSourceOrg       = "org"
+)
+
+var EffectiveSourceTypes = []string{EffectiveSourceStack, EffectiveSourceNamespace, EffectiveSourceOrg}
+
+var (
+	effectiveIacConfigAttrTypes = map[string]attr.Type{
+		"terraform_version":  types.StringType,
+		"terragrunt_version": types.StringType,
+		"opentofu_version":   types.StringType,
+		"source":             types.StringType,
+	}
+	effectiveRunnerConfigAttrTypes = map[string]attr.Type{
+		"mode":   types.StringType,
+		"groups": types.ListType{ElemType: types.StringType},
+		"source": types.StringType,
+	}
+	effectiveDeploymentApprovalPolicyAttrTypes = map[string]attr.Type{
+		"rules": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"type":       types.StringType,
+			"parameters": jsontypes.NormalizedType{},
+			"source":     types.StringType,
+		}}},
+	}
+	effectiveCapabilityConfigAttrTypes = map[string]attr.Type{
+		"status": types.StringType,
+		"source": types.StringType,
+	}
+	effectiveCapabilitiesAttrTypes = map[string]attr.Type{
+		"deploy_on_push":  types.ObjectType{AttrTypes: effectiveCapabilityConfigAttrTypes},
+		"plan_on_pr":      types.ObjectType{AttrTypes: effectiveCapabilityConfigAttrTypes},
+		"drift_detection": types.ObjectType{AttrTypes: effectiveCapabilityConfigAttrTypes},
+	}
+)
+
+// UpdateEffectiveConfig resolves the settings the stack actually uses by walking the stack, its namespace and the org
+// configuration. A level wins over the levels below it when it does not allow being overridden.
+func UpdateEffectiveConfig(ctx context.Context, namespace *sdkNamespace.Namespace, org *sdkOrganization.OrgConfiguration, state *ResourceModel) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if namespace == nil {
+		namespace = new(sdkNamespace.Namespace)
+	}
+	if org == nil {
+		org = new(sdkOrganization.OrgConfiguration)
+	}
+
+	var diags diag.Diagnostics
+
+	state.EffectiveIacConfig, diags = objectValueOrNull(ctx, effectiveIacConfigAttrTypes, effectiveIacConfig(state.IacConfig, namespace.IacConfig, org.IacConfig))
+	retVal.Append(diags...)
+	state.EffectiveRunnerConfig, diags = objectValueOrNull(ctx, effectiveRunnerConfigAttrTypes, effectiveRunnerConfig(state.RunnerConfig, namespace.RunnerConfig, org.RunnerConfig))
+	retVal.Append(diags...)
+	state.EffectiveDeploymentApprovalPolicy, diags = objectValueOrNull(ctx, effectiveDeploymentApprovalPolicyAttrTypes, effectiveDeploymentApprovalPolicy(state.DeploymentApprovalPolicy, namespace.DeploymentApprovalPolicy))
+	retVal.Append(diags...)
+	state.EffectiveCapabilities, diags = objectValueOrNull(ctx, effectiveCapabilitiesAttrTypes, effectiveCapabilities(state.Capabilities, namespace.Capabilities))
+	retVal.Append(diags...)
+
+	return retVal
+}
+
+// NullEffectiveConfig sets the effective settings to null, for when they can't be resolved.
+func NullEffectiveConfig(state *ResourceModel) {
+	state.EffectiveIacConfig = types.ObjectNull(effectiveIacConfigAttrTypes)
+	state.EffectiveRunnerConfig = types.ObjectNull(effectiveRunnerConfigAttrTypes)
+	state.EffectiveDeploymentApprovalPolicy = types.ObjectNull(effectiveDeploymentApprovalPolicyAttrTypes)
+	state.EffectiveCapabilities = types.ObjectNull(effectiveCapabilitiesAttrTypes)
+}
+
+//region Private
+
+func objectValueOrNull[T any](ctx context.Context, attrTypes map[string]attr.Type, model *T) (types.Object, diag.Diagnostics) {
+	if model == nil {
+		return types.ObjectNull(attrTypes), nil
+	}
+
+	return types.ObjectValueFrom(ctx, attrTypes, model)
+}
+
+func effectiveIacConfig(stack *cross_models.IacConfigModel, namespace *sdkNamespace.IacConfig, org *sdkOrganization.IacConfig) *EffectiveIacConfigModel {
+	var retVal *EffectiveIacConfigModel
+
+	if stack != nil && (helpers.IsKnown(stack.TerraformVersion) || helpers.IsKnown(stack.TerragruntVersion) || helpers.IsKnown(stack.OpentofuVersion)) {
+		retVal = &EffectiveIacConfigModel{
+			TerraformVersion:  stack.TerraformVersion,
+			TerragruntVersion: stack.TerragruntVersion,
+			OpentofuVersion:   stack.OpentofuVersion,
+			Source:            types.StringValue(EffectiveSourceStack),
+		}
+	} else if namespace != nil && (namespace.TerraformVersion != nil || namespace.TerragruntVersion != nil || namespace.OpentofuVersion != nil) {
+		retVal = &EffectiveIacConfigModel{
+			TerraformVersion:  helpers.StringValueOrNull(namespace.TerraformVersion),
+			TerragruntVersion: helpers.StringValueOrNull(namespace.TerragruntVersion),
+			OpentofuVersion:   helpers.StringValueOrNull(namespace.OpentofuVersion),
+			Source:            types.StringValue(EffectiveSourceNamespace),
+		}
+	} else if org != nil && (org.TerraformVersion != nil || org.TerragruntVersion != nil || org.OpentofuVersion != nil) {
+		retVal = &EffectiveIacConfigModel{
+			TerraformVersion:  helpers.StringValueOrNull(org.TerraformVersion),
+			TerragruntVersion: helpers.StringValueOrNull(org.TerragruntVersion),
+			OpentofuVersion:   helpers.StringValueOrNull(org.OpentofuVersion),
+			Source:            types.StringValue(EffectiveSourceOrg),
+		}
+	}
+
+	return retVal
+}
+
+func effectiveRunnerConfig(stack *cross_models.RunnerConfigModel, namespace *sdkNamespace.RunnerConfig, org *sdkOrganization.RunnerConfig) *EffectiveRunnerConfigModel {
+	orgRunnerConfig := func() *EffectiveRunnerConfigModel {
+		return &EffectiveRunnerConfigModel{
+			Mode:   helpers.StringValueOrNull(org.Mode),
+			Groups: helpers.StringPointerSliceToTfList(org.Groups),
+			Source: types.StringValue(EffectiveSourceOrg),
+		}
+	}
+	namespaceRunnerConfig := func() *EffectiveRunnerConfigModel {
+		return &EffectiveRunnerConfigModel{
+			Mode:   helpers.StringValueOrNull(namespace.Mode),
+			Groups: helpers.StringPointerSliceToTfList(namespace.Groups),
+			Source: types.StringValue(EffectiveSourceNamespace),
+		}
+	}
+
+	orgIsSet := org != nil && org.Mode != nil
+	namespaceIsSet := namespace != nil && namespace.Mode != nil
+
+	var retVal *EffectiveRunnerConfigModel
+
+	if orgIsSet && isNotOverridable(org.IsOverridable) {
+		retVal = orgRunnerConfig()
+	} else if namespaceIsSet && isNotOverridable(namespace.IsOverridable) {
+		retVal = namespaceRunnerConfig()
+	} else if stack != nil && helpers.IsKnown(stack.Mode) {
+		retVal = &EffectiveRunnerConfigModel{
+			Mode:   stack.Mode,
+			Groups: stack.Groups,
+			Source: types.StringValue(EffectiveSourceStack),
+		}
+	} else if namespaceIsSet {
+		retVal = namespaceRunnerConfig()
+	} else if orgIsSet {
+		retVal = orgRunnerConfig()
+	}
+
+	return retVal
+}
+
+// effectiveDeploymentApprovalPolicy applies the override behavior of the namespace policy: with 'deny' the stack
+// rules are ignored, with 'extended' the stack rules are added to the namespace rules and with 'allow' the stack
+// rules replace the namespace rules.
+func effectiveDeploymentApprovalPolicy(stack *cross_models.DeploymentApprovalPolicyModel, namespace *sdkNamespace.DeploymentApprovalPolicy) *EffectiveDeploymentApprovalPolicyModel {
+	var namespaceRules []*EffectiveDeploymentApprovalPolicyRuleModel
+	var stackRules []*EffectiveDeploymentApprovalPolicyRuleModel
+	overrideBehavior := cmTypes.Allow
+
+	if namespace != nil {
+		for _, rule := range cross_models.UpdateStateAfterReadDeploymentApprovalPolicyRules(namespace.Rules) {
+			namespaceRules = append(namespaceRules, effectiveDeploymentApprovalPolicyRule(rule, EffectiveSourceNamespace))
+		}
+
+		if namespace.OverrideBehavior != nil {
+			overrideBehavior = *namespace.OverrideBehavior
+		}
+	}
+
+	if stack != nil {
+		for _, rule := range stack.Rules {
+			stackRules = append(stackRules, effectiveDeploymentApprovalPolicyRule(rule, EffectiveSourceStack))
+		}
+	}
+
+	var rules []*EffectiveDeploymentApprovalPolicyRuleModel
+
+	switch {
+	case overrideBehavior == cmTypes.Deny || stack == nil:
+		rules = namespaceRules
+	case overrideBehavior == cmTypes.Extended:
+		rules = append(namespaceRules, stackRules...)
+	default:
+		rules = stackRules
+	}
+
+	var retVal *EffectiveDeploymentApprovalPolicyModel
+
+	if len(rules) > 0 {
+		retVal = &EffectiveDeploymentApprovalPolicyModel{Rules: rules}
+	}
+
+	return retVal
+}
+
+func effectiveDeploymentApprovalPolicyRule(rule *cross_models.DeploymentApprovalPolicyRuleModel, source string) *EffectiveDeploymentApprovalPolicyRuleModel {
+	return &EffectiveDeploymentApprovalPolicyRuleModel{
+		Type:       rule.Type,
+		Parameters: rule.Parameters,
+		Source:     types.StringValue(source),
+	}
+}
+
+func effectiveCapabilities(stack *CapabilitiesModel, namespace *sdkNamespace.Capabilities) *EffectiveCapabilitiesModel {
+	if stack == nil {
+		stack = new(CapabilitiesModel)
+	}
+	if namespace == nil {
+		namespace = new(sdkNamespace.Capabilities)
+	}
+
+	retVal := &EffectiveCapabilitiesModel{
+		DeployOnPush:   effectiveCapabilityConfig(stack.DeployOnPush, namespace.DeployOnPush),
+		PlanOnPr:       effectiveCapabilityConfig(stack.PlanOnPr, namespace.PlanOnPr),
+		DriftDetection: effectiveCapabilityConfig(stack.DriftDetection, namespace.DriftDetection),
+	}
+
+	if helpers.IsAllNilFields(retVal) {
+		retVal = nil
+	}
+
+	return retVal
+}
+
+func effectiveCapabilityConfig(stack *CapabilityConfigModel, namespace *sdkNamespace.CapabilityConfig) *EffectiveCapabilityConfigModel {
+	namespaceIsSet := namespace != nil && namespace.Status != nil
+
+	var retVal *EffectiveCapabilityConfigModel
+
+	if namespaceIsSet && isNotOverridable(namespace.IsOverridable) {
+		retVal = &EffectiveCapabilityConfigModel{Status: types.StringValue(*namespace.Status), Source: types.StringValue(EffectiveSourceNamespace)}
+	} else if stack != nil && helpers.IsKnown(stack.Status) {
+		retVal = &EffectiveCapabilityConfigModel{Status: stack.Status, Source: types.StringValue(EffectiveSourceStack)}
+	} else if namespaceIsSet {
+		retVal = &EffectiveCapabilityConfigModel{Status: types.StringValue(*namespace.Status), Source: types.StringValue(EffectiveSourceNamespace)}
+	}
+
+	return retVal
+}
+
+func isNotOverridable(isOverridable *bool) bool {
+	return isOverridable != nil && *isOverridable == false
+}
+
+//endregion
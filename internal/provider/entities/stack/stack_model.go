@@ -2,6 +2,8 @@ package stack
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,6 +22,15 @@ type ResourceModel struct {
 	RunnerConfig             *cross_models.RunnerConfigModel             `tfsdk:"runner_config"`
 	Capabilities             *CapabilitiesModel                          `tfsdk:"capabilities"`
 	AutoSync                 *cross_models.AutoSyncModel                 `tfsdk:"auto_sync"`
+	DeletionProtection       types.Bool                                  `tfsdk:"deletion_protection"`
+	Timeouts                 timeouts.Value                              `tfsdk:"timeouts"`
+
+	// The effective settings are computed objects, which are unknown in the plan of a new stack, so they can't be
+	// read into pointers to their models.
+	EffectiveIacConfig                types.Object `tfsdk:"effective_iac_config"`
+	EffectiveRunnerConfig             types.Object `tfsdk:"effective_runner_config"`
+	EffectiveDeploymentApprovalPolicy types.Object `tfsdk:"effective_deployment_approval_policy"`
+	EffectiveCapabilities             types.Object `tfsdk:"effective_capabilities"`
 }
 
 type VcsInfoModel struct {
@@ -51,3 +62,37 @@ type CapabilitiesModel struct {
 type CapabilityConfigModel struct {
 	Status types.String `tfsdk:"status"`
 }
+
+type EffectiveIacConfigModel struct {
+	TerraformVersion  types.String `tfsdk:"terraform_version"`
+	TerragruntVersion types.String `tfsdk:"terragrunt_version"`
+	OpentofuVersion   types.String `tfsdk:"opentofu_version"`
+	Source            types.String `tfsdk:"source"`
+}
+
+type EffectiveRunnerConfigModel struct {
+	Mode   types.String `tfsdk:"mode"`
+	Groups types.List   `tfsdk:"groups"`
+	Source types.String `tfsdk:"source"`
+}
+
+type EffectiveDeploymentApprovalPolicyModel struct {
+	Rules []*EffectiveDeploymentApprovalPolicyRuleModel `tfsdk:"rules"`
+}
+
+type EffectiveDeploymentApprovalPolicyRuleModel struct {
+	Type       types.String         `tfsdk:"type"`
+	Parameters jsontypes.Normalized `tfsdk:"parameters"`
+	Source     types.String         `tfsdk:"source"`
+}
+
+type EffectiveCapabilitiesModel struct {
+	DeployOnPush   *EffectiveCapabilityConfigModel `tfsdk:"deploy_on_push"`
+	PlanOnPr       *EffectiveCapabilityConfigModel `tfsdk:"plan_on_pr"`
+	DriftDetection *EffectiveCapabilityConfigModel `tfsdk:"drift_detection"`
+}
+
+type EffectiveCapabilityConfigModel struct {
+	Status types.String `tfsdk:"status"`
+	Source types.String `tfsdk:"source"`
+}
@@ -1,6 +1,7 @@
 package stack_dependency
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -10,6 +11,7 @@ type ResourceModel struct {
 	DependsOnStackId types.String      `tfsdk:"depends_on_stack_id"`
 	TriggerOption    types.String      `tfsdk:"trigger_option"`
 	References       []*ReferenceModel `tfsdk:"references"`
+	Timeouts         timeouts.Value    `tfsdk:"timeouts"`
 }
 
 type ReferenceModel struct {
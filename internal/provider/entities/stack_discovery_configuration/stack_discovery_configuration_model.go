@@ -2,6 +2,7 @@ package stack_discovery_configuration
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -12,6 +13,7 @@ type ResourceModel struct {
 	Description types.String       `tfsdk:"description"`
 	VcsPatterns []*VcsPatternModel `tfsdk:"vcs_patterns"`
 	StackConfig *StackConfigModel  `tfsdk:"stack_config"`
+	Timeouts    timeouts.Value     `tfsdk:"timeouts"`
 }
 
 type VcsPatternModel struct {
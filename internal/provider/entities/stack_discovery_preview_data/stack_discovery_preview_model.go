@@ -0,0 +1,19 @@
+package stack_discovery_preview_data
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceModel struct {
+	PathPatterns        types.List              `tfsdk:"path_patterns"`
+	ExcludePathPatterns types.List              `tfsdk:"exclude_path_patterns"`
+	Paths               types.List              `tfsdk:"paths"`
+	Directory           types.String            `tfsdk:"directory"`
+	Stacks              []*DiscoveredStackModel `tfsdk:"stacks"`
+}
+
+type DiscoveredStackModel struct {
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	MatchedPattern types.String `tfsdk:"matched_pattern"`
+}
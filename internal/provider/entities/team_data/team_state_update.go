@@ -3,6 +3,7 @@ package team_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkTeam "github.com/control-monkey/controlmonkey-sdk-go/services/team"
+	tfTeam "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/team"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,29 @@ func UpdateStateAfterRead(apiEntity *sdkTeam.Team, state *ResourceModel, diagnos
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkTeam.Team, state *ListModel) {
+	state.Teams = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.Teams = append(state.Teams, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkTeam.Team) ItemModel {
+	var retVal ItemModel
+	var entity tfTeam.ResourceModel
+
+	tfTeam.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.CustomIdpId = entity.CustomIdpId
+
+	return retVal
+}
+
+//endregion
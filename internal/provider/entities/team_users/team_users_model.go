@@ -2,13 +2,15 @@ package team_users
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	TeamId types.String `tfsdk:"team_id"`
-	Users  []*UserModel `tfsdk:"users"`
+	ID       types.String   `tfsdk:"id"`
+	TeamId   types.String   `tfsdk:"team_id"`
+	Users    []*UserModel   `tfsdk:"users"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type UserModel struct { //When new field is added consider Hash() function
@@ -2,6 +2,7 @@ package template
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -15,6 +16,9 @@ type ResourceModel struct {
 	SkipStateRefreshOnDestroy types.Bool                      `tfsdk:"skip_state_refresh_on_destroy"`
 	IacConfig                 *IacConfigModel                 `tfsdk:"iac_config"`
 	RunnerConfig              *cross_models.RunnerConfigModel `tfsdk:"runner_config"`
+	AdoptExisting             types.Bool                      `tfsdk:"adopt_existing"`
+	DeletionProtection        types.Bool                      `tfsdk:"deletion_protection"`
+	Timeouts                  timeouts.Value                  `tfsdk:"timeouts"`
 }
 
 type VcsInfoModel struct {
@@ -1,6 +1,8 @@
 package template_data
 
 import (
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	tfTemplate "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/template"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,3 +10,22 @@ type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
+
+// ItemModel describes a single entity returned by the plural data source.
+type ItemModel struct {
+	ID                        types.String                    `tfsdk:"id"`
+	Name                      types.String                    `tfsdk:"name"`
+	IacType                   types.String                    `tfsdk:"iac_type"`
+	Description               types.String                    `tfsdk:"description"`
+	VcsInfo                   *tfTemplate.VcsInfoModel        `tfsdk:"vcs_info"`
+	Policy                    *tfTemplate.PolicyModel         `tfsdk:"policy"`
+	SkipStateRefreshOnDestroy types.Bool                      `tfsdk:"skip_state_refresh_on_destroy"`
+	IacConfig                 *tfTemplate.IacConfigModel      `tfsdk:"iac_config"`
+	RunnerConfig              *cross_models.RunnerConfigModel `tfsdk:"runner_config"`
+}
+
+type ListModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Templates  []*ItemModel `tfsdk:"templates"`
+}
@@ -3,6 +3,7 @@ package template_data
 import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	sdkTemplate "github.com/control-monkey/controlmonkey-sdk-go/services/template"
+	tfTemplate "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/template"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -11,3 +12,35 @@ func UpdateStateAfterRead(apiEntity *sdkTemplate.Template, state *ResourceModel,
 	state.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
 	state.Name = types.StringValue(controlmonkey.StringValue(apiEntity.Name))
 }
+
+func UpdateStateAfterList(apiEntities []*sdkTemplate.Template, state *ListModel) {
+	state.Templates = make([]*ItemModel, 0, len(apiEntities))
+
+	for _, apiEntity := range apiEntities {
+		item := updateStateAfterReadItem(apiEntity)
+		state.Templates = append(state.Templates, &item)
+	}
+}
+
+//region Private
+
+func updateStateAfterReadItem(apiEntity *sdkTemplate.Template) ItemModel {
+	var retVal ItemModel
+	var entity tfTemplate.ResourceModel
+
+	tfTemplate.UpdateStateAfterRead(apiEntity, &entity)
+
+	retVal.ID = types.StringValue(controlmonkey.StringValue(apiEntity.ID))
+	retVal.Name = entity.Name
+	retVal.IacType = entity.IacType
+	retVal.Description = entity.Description
+	retVal.VcsInfo = entity.VcsInfo
+	retVal.Policy = entity.Policy
+	retVal.SkipStateRefreshOnDestroy = entity.SkipStateRefreshOnDestroy
+	retVal.IacConfig = entity.IacConfig
+	retVal.RunnerConfig = entity.RunnerConfig
+
+	return retVal
+}
+
+//endregion
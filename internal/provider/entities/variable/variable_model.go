@@ -2,6 +2,7 @@ package variable
 
 import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,4 +20,5 @@ type ResourceModel struct {
 	Description                types.String                   `tfsdk:"description"`
 	ValueConditions            []*cross_models.ConditionModel `tfsdk:"value_conditions"`
 	BlueprintVariableManagedBy types.String                   `tfsdk:"blueprint_variable_managed_by"`
+	Timeouts                   timeouts.Value                 `tfsdk:"timeouts"`
 }
@@ -43,7 +43,7 @@ func (r *EventsSubscriptionsResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_events_subscriptions"
 }
 
-func (r *EventsSubscriptionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *EventsSubscriptionsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys events subscriptions.",
 		Attributes: map[string]schema.Attribute{
@@ -113,6 +113,9 @@ func (r *EventsSubscriptionsResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -170,6 +173,10 @@ func (r *EventsSubscriptionsResource) ValidateConfig(ctx context.Context, req re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *EventsSubscriptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state tfEventsSubscriptions.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -178,6 +185,12 @@ func (r *EventsSubscriptionsResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID
 	scope, scopeId := r.breakdownId(id)
 	res, err := r.client.Client.notification.ListEventSubscriptions(ctx, scope, scopeId)
@@ -191,7 +204,7 @@ func (r *EventsSubscriptionsResource) Read(ctx context.Context, req resource.Rea
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read events subscriptions for resource '%s'", resourceIdentifier), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read events subscriptions for resource '%s'", resourceIdentifier), "", err)...)
 		return
 	}
 
@@ -207,6 +220,12 @@ func (r *EventsSubscriptionsResource) Read(ctx context.Context, req resource.Rea
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *EventsSubscriptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfEventsSubscriptions.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -215,6 +234,12 @@ func (r *EventsSubscriptionsResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := tfEventsSubscriptions.Merge(&plan, nil, commons.CreateMerger)
 	diags, newEntities := r.createEntities(ctx, mergeResult.EntitiesToCreate, plan.Scope.ValueString(), plan.ScopeId.ValueStringPointer())
 	resp.Diagnostics.Append(diags...)
@@ -234,6 +259,12 @@ func (r *EventsSubscriptionsResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *EventsSubscriptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfEventsSubscriptions.ResourceModel
 	var state tfEventsSubscriptions.ResourceModel
@@ -247,6 +278,12 @@ func (r *EventsSubscriptionsResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	scope := plan.Scope.ValueString()
 	scopeId := plan.ScopeId.ValueStringPointer()
 	mergeResult := tfEventsSubscriptions.Merge(&plan, &state, commons.UpdateMerger)
@@ -274,6 +311,12 @@ func (r *EventsSubscriptionsResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *EventsSubscriptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfEventsSubscriptions.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -282,6 +325,12 @@ func (r *EventsSubscriptionsResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	scope := state.Scope.ValueString()
 	scopeId := state.ScopeId.ValueStringPointer()
 	mergeResult := tfEventsSubscriptions.Merge(nil, &state, commons.DeleteMerger)
@@ -315,15 +364,9 @@ func (r *EventsSubscriptionsResource) createEntities(ctx context.Context, entiti
 						subscriptionIdentifier)),
 				}
 			} else if commons.IsNotFoundResponseError(err) {
-				diags = diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to add subscription %s. Error: %s",
-						subscriptionIdentifier, err)),
-				}
+				diags = commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to add subscription %s", subscriptionIdentifier), err)
 			} else {
-				diags = diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to add subscription %s.", subscriptionIdentifier),
-						err.Error()),
-				}
+				diags = commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to add subscription %s.", subscriptionIdentifier), "", err)
 			}
 		}
 
@@ -346,15 +389,9 @@ func (r *EventsSubscriptionsResource) deleteEntities(ctx context.Context, entiti
 
 		if err != nil {
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to delete subscription id '%s' from resource %s. Error: %s",
-						*e.ID, resourceIdentifier, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to delete subscription id '%s' from resource %s", *e.ID, resourceIdentifier), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to delete subscription id '%s' from resource %s.", *e.ID, resourceIdentifier),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to delete subscription id '%s' from resource %s.", *e.ID, resourceIdentifier), "", err)
 			}
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -21,17 +22,17 @@ func testAccEventsSubscriptionsResourceSetup() string {
 
 	return fmt.Sprintf(`
 resource "cm_namespace" "namespace" {
-  name = "namespace"
+  name = "`+test_helpers.NamePrefix+`namespace"
 }
 
 resource "cm_notification_endpoint" "notification_endpoint" {
-  name = "test"
+  name = "`+test_helpers.NamePrefix+`test"
   protocol = "slack"
   url = "%s"
 }
 
 resource "cm_notification_endpoint" "notification_endpoint_2" {
-  name = "test2"
+  name = "`+test_helpers.NamePrefix+`test2"
   protocol = "slack"
   url = "%s"
 }
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const fakeApiTeamName = test_helpers.NamePrefix + "Fake API Team"
+
+func TestAccFakeApiServerErrors(t *testing.T) {
+	skipWithoutFakeApi(t)
+
+	for _, statusCode := range []int{http.StatusConflict, http.StatusTooManyRequests, http.StatusInternalServerError} {
+		t.Run(http.StatusText(statusCode), func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				PreCheck:                 func() { testAccPreCheck(t) },
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							// Too Many Requests is retried, so it fails only when every retry is rejected as well.
+							count := 1
+							if statusCode == http.StatusTooManyRequests {
+								count += maxTooManyRequestsRetries
+							}
+							testAccFakeApi.InjectError(http.MethodPost, "/iam/org/team", statusCode, count)
+						},
+						Config:      fakeApiTeamConfig(),
+						ExpectError: regexp.MustCompile(fmt.Sprintf("Status code: %d", statusCode)),
+					},
+					// The injected errors were used up, so the next apply succeeds.
+					{
+						Config: fakeApiTeamConfig(),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(teamResource(teamResourceName), "name", fakeApiTeamName),
+							resource.TestCheckResourceAttrSet(teamResource(teamResourceName), "id"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccFakeApiNotFound(t *testing.T) {
+	skipWithoutFakeApi(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fakeApiTeamConfig(),
+			},
+			// A team that is not found on refresh is removed from the state and planned for creation.
+			{
+				PreConfig: func() {
+					testAccFakeApi.InjectError(http.MethodGet, "/iam/org/team/", http.StatusNotFound, 1)
+				},
+				Config:             fakeApiTeamConfig(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// A team that is already gone on destroy is not an error.
+			{
+				PreConfig: func() {
+					testAccFakeApi.InjectError(http.MethodDelete, "/iam/org/team/", http.StatusNotFound, 1)
+				},
+				Config:  providerConfig,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func skipWithoutFakeApi(t *testing.T) {
+	if testAccFakeApi == nil {
+		t.Skipf("requires the fake API, set %s=1", fakeApiEnvVar)
+	}
+
+	t.Cleanup(testAccFakeApi.ClearErrors)
+}
+
+func fakeApiTeamConfig() string {
+	return providerConfig + fmt.Sprintf(`
+resource "%s" "%s" {
+ name = "%s"
+}
+`, cmTeam, teamResourceName, fakeApiTeamName)
+}
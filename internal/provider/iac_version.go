@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	terraformVersionAttributeName  = "terraform_version"
+	terragruntVersionAttributeName = "terragrunt_version"
+	opentofuVersionAttributeName   = "opentofu_version"
+)
+
+var iacVersionAttributeNames = []string{terraformVersionAttributeName, terragruntVersionAttributeName, opentofuVersionAttributeName}
+
+// iacVersions holds the versions of an iac_config, which is modeled separately by every resource.
+type iacVersions struct {
+	TerraformVersion  types.String
+	TerragruntVersion types.String
+	OpentofuVersion   types.String
+}
+
+func (v iacVersions) byAttributeName() map[string]types.String {
+	return map[string]types.String{
+		terraformVersionAttributeName:  v.TerraformVersion,
+		terragruntVersionAttributeName: v.TerragruntVersion,
+		opentofuVersionAttributeName:   v.OpentofuVersion,
+	}
+}
+
+// validateIacVersionsMatchIacType returns an error for every version in the iac_config that cannot be used by the
+// given iac_type.
+func validateIacVersionsMatchIacType(iacConfigPath path.Path, iacType types.String, versions iacVersions) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if !helpers.IsKnown(iacType) {
+		return retVal
+	}
+
+	var notAllowed []string
+
+	switch iacType.ValueString() {
+	case cmTypes.Terraform:
+		notAllowed = []string{terragruntVersionAttributeName, opentofuVersionAttributeName}
+	case cmTypes.Opentofu:
+		notAllowed = []string{terraformVersionAttributeName, terragruntVersionAttributeName}
+	}
+
+	byAttributeName := versions.byAttributeName()
+
+	for _, name := range notAllowed {
+		if byAttributeName[name].IsNull() == false {
+			retVal.AddAttributeError(
+				iacConfigPath.AtName(name),
+				validationError, fmt.Sprintf("%s cannot be configured when iac_type is '%s'", iacConfigPath.AtName(name), iacType.ValueString()),
+			)
+		}
+	}
+
+	return retVal
+}
+
+// iacVersionsBelowMinimumWarnings warns about every version in the iac_config that is lower than the minimum
+// configured in the provider. Version constraints are not compared.
+func (c *ControlMonkeyAPIClient) iacVersionsBelowMinimumWarnings(iacConfigPath path.Path, versions iacVersions) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if c == nil {
+		return retVal
+	}
+
+	byAttributeName := versions.byAttributeName()
+
+	for _, name := range iacVersionAttributeNames {
+		value := byAttributeName[name]
+		minimum := c.MinimumIacVersions[name]
+
+		if minimum == nil || !helpers.IsKnown(value) {
+			continue
+		}
+
+		if v, err := version.NewVersion(value.ValueString()); err == nil && v.LessThan(minimum) {
+			retVal.AddAttributeWarning(
+				iacConfigPath.AtName(name),
+				"IaC version below minimum",
+				fmt.Sprintf("%s %s is lower than the minimum version %s configured in the provider.", iacConfigPath.AtName(name), v, minimum),
+			)
+		}
+	}
+
+	return retVal
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	goVersion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateIacVersionsMatchIacType(t *testing.T) {
+	cases := map[string]struct {
+		iacType  string
+		versions iacVersions
+		errors   int
+	}{
+		"terraform with terraform version": {
+			iacType:  "terraform",
+			versions: iacVersions{TerraformVersion: types.StringValue("1.5.7"), TerragruntVersion: types.StringNull(), OpentofuVersion: types.StringNull()},
+		},
+		"terraform with opentofu version": {
+			iacType:  "terraform",
+			versions: iacVersions{TerraformVersion: types.StringNull(), TerragruntVersion: types.StringNull(), OpentofuVersion: types.StringValue("1.6.0")},
+			errors:   1,
+		},
+		"opentofu with terraform and terragrunt versions": {
+			iacType:  "opentofu",
+			versions: iacVersions{TerraformVersion: types.StringValue("1.5.7"), TerragruntVersion: types.StringValue("0.45.3"), OpentofuVersion: types.StringNull()},
+			errors:   2,
+		},
+		"terragrunt with all versions": {
+			iacType:  "terragrunt",
+			versions: iacVersions{TerraformVersion: types.StringValue("1.5.7"), TerragruntVersion: types.StringValue("0.45.3"), OpentofuVersion: types.StringValue("1.6.0")},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateIacVersionsMatchIacType(path.Root("iac_config"), types.StringValue(c.iacType), c.versions)
+
+			if diags.ErrorsCount() != c.errors {
+				t.Errorf("expected %d errors, got %v", c.errors, diags)
+			}
+		})
+	}
+}
+
+func TestIacVersionsBelowMinimumWarnings(t *testing.T) {
+	client := &ControlMonkeyAPIClient{
+		MinimumIacVersions: map[string]*goVersion.Version{
+			terraformVersionAttributeName: goVersion.Must(goVersion.NewVersion("1.5.0")),
+		},
+	}
+
+	versions := iacVersions{TerraformVersion: types.StringValue("1.4.6"), TerragruntVersion: types.StringValue("0.45.3"), OpentofuVersion: types.StringNull()}
+	if diags := client.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions); diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning for a version below the minimum, got %v", diags)
+	}
+
+	versions.TerraformVersion = types.StringValue("~> 1.4.0")
+	if diags := client.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions); diags.WarningsCount() != 0 {
+		t.Errorf("expected version constraints to be skipped, got %v", diags)
+	}
+
+	var unconfigured *ControlMonkeyAPIClient
+	if diags := unconfigured.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions); len(diags) != 0 {
+		t.Errorf("expected no diagnostics without a configured provider, got %v", diags)
+	}
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	httpLoggingSubsystem = "cm_http"
+	redactedValue        = "***"
+)
+
+var bearerTokenRegex = regexp.MustCompile(`(?i)bearer\s+[^\s"]+`)
+
+// loggingTransport logs every request sent to the ControlMonkey API and its response under the cm_http subsystem.
+// Secrets are redacted before anything is logged. The subsystem inherits the TF_LOG_PROVIDER_CM level and can be
+// tuned separately using TF_LOG_PROVIDER_CM_HTTP.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+func newLoggingTransport(transport http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{transport: transport}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLoggingSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_CM", "HTTP"))
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, httpLoggingSubsystem, bearerTokenRegex)
+
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, httpLoggingSubsystem, "Sending HTTP request", map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             req.URL.String(),
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    redactBody(requestBody),
+	})
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemError(ctx, httpLoggingSubsystem, "HTTP request failed", map[string]interface{}{
+			"http_method": req.Method,
+			"http_url":    req.URL.String(),
+			"duration_ms": duration.Milliseconds(),
+			"error":       err.Error(),
+		})
+
+		return resp, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, httpLoggingSubsystem, "Received HTTP response", map[string]interface{}{
+		"http_method":           req.Method,
+		"http_url":              req.URL.String(),
+		"http_status_code":      resp.StatusCode,
+		"http_response_headers": redactHeaders(resp.Header),
+		"http_response_body":    redactBody(responseBody),
+		"duration_ms":           duration.Milliseconds(),
+	})
+
+	return resp, nil
+}
+
+//region Private
+
+// readBody reads the whole body and replaces it with an identical reader, so it can still be consumed by the caller.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	retVal, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(retVal))
+
+	return retVal, nil
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	retVal := make(map[string]string, len(header))
+
+	for k, v := range header {
+		if strings.EqualFold(k, "Authorization") {
+			retVal[k] = redactedValue
+		} else {
+			retVal[k] = strings.Join(v, ", ")
+		}
+	}
+
+	return retVal
+}
+
+// redactBody removes secrets from a JSON body. Bodies that are not JSON are logged as is, apart from bearer tokens.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return bearerTokenRegex.ReplaceAllString(string(body), redactedValue)
+	}
+
+	retVal, err := json.Marshal(redactJson(v))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(retVal)
+}
+
+func redactJson(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, value := range t {
+			if isSecretField(k) {
+				if value != nil {
+					t[k] = redactedValue
+				}
+			} else {
+				t[k] = redactJson(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range t {
+			t[i] = redactJson(value)
+		}
+	}
+
+	return v
+}
+
+// isSecretField reports whether the key of a JSON object holds a secret: Slack bot tokens, notification endpoint
+// webhook URLs, API tokens and variable values. The keys are redacted wherever they appear, partial updates do not
+// carry the fields that tell a sensitive value or a notification endpoint apart.
+func isSecretField(key string) bool {
+	switch key {
+	case "botAuthToken", "token", "url", "value":
+		return true
+	}
+
+	return false
+}
+
+//endregion
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		body   string
+		secret string
+	}{
+		"sensitive variable value": {
+			body:   `{"entity":{"key":"password","value":"s3cr3t","isSensitive":true}}`,
+			secret: "s3cr3t",
+		},
+		"slack bot token": {
+			body:   `{"response":{"items":[{"name":"slack","botAuthToken":"xoxb-123"}]}}`,
+			secret: "xoxb-123",
+		},
+		"variable value without isSensitive": {
+			body:   `{"value":"s3cr3t"}`,
+			secret: "s3cr3t",
+		},
+		"webhook url": {
+			body:   `{"entity":{"name":"hook","protocol":"slack","url":"https://hooks.slack.com/services/T0/B0/X"}}`,
+			secret: "hooks.slack.com",
+		},
+		"webhook url without protocol": {
+			body:   `{"url":"https://hooks.slack.com/services/T0/B0/X"}`,
+			secret: "hooks.slack.com",
+		},
+		"bearer token in non json body": {
+			body:   `Authorization: Bearer abc.def`,
+			secret: "abc.def",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			redacted := redactBody([]byte(c.body))
+
+			if strings.Contains(redacted, c.secret) {
+				t.Errorf("expected %q to be redacted, got %s", c.secret, redacted)
+			}
+			if !strings.Contains(redacted, redactedValue) {
+				t.Errorf("expected redaction marker in %s", redacted)
+			}
+		})
+	}
+}
+
+func TestRedactBodyKeepsOtherFields(t *testing.T) {
+	redacted := redactBody([]byte(`{"entity":{"key":"region","value":"us-east-1","isSensitive":false}}`))
+
+	if !strings.Contains(redacted, `"key":"region"`) || !strings.Contains(redacted, `"isSensitive":false`) {
+		t.Errorf("expected the fields that are not secrets to be kept, got %s", redacted)
+	}
+	if strings.Contains(redacted, "us-east-1") {
+		t.Errorf("expected the variable value to be redacted, got %s", redacted)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer token")
+	header.Set("Content-Type", "application/json")
+
+	redacted := redactHeaders(header)
+
+	if redacted["Authorization"] != redactedValue {
+		t.Errorf("expected Authorization header to be redacted, got %s", redacted["Authorization"])
+	}
+	if redacted["Content-Type"] != "application/json" {
+		t.Errorf("expected Content-Type header to be kept, got %s", redacted["Content-Type"])
+	}
+}
+
+type testRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLoggingTransportRedactsLoggedTraffic(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_CM", "DEBUG")
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	const responseBody = `{"response":{"items":[{"id":"ne-1","url":"https://hooks.slack.com/services/T0/B0/X","botAuthToken":"xoxb-123"}]}}`
+
+	var sentBody []byte
+	transport := newLoggingTransport(testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		sentBody, _ = io.ReadAll(req.Body)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(responseBody)),
+			Request:    req,
+		}, nil
+	}))
+
+	const requestBody = `{"value":"s3cr3t","description":"partial update"}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPatch, "https://api.example.com/variable/var-1", strings.NewReader(requestBody))
+	req.Header.Set("Authorization", "Bearer my-token")
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	received, _ := io.ReadAll(resp.Body)
+
+	if string(sentBody) != requestBody {
+		t.Errorf("expected the request body to be sent unchanged, got %s", sentBody)
+	}
+	if string(received) != responseBody {
+		t.Errorf("expected the response body to be returned unchanged, got %s", received)
+	}
+
+	logged := logs.String()
+	for _, secret := range []string{"s3cr3t", "my-token", "hooks.slack.com", "xoxb-123"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("expected %q to be redacted from the logs, got %s", secret, logged)
+		}
+	}
+	for _, expected := range []string{"Sending HTTP request", "Received HTTP response", "partial update", "ne-1"} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("expected the logs to contain %q, got %s", expected, logged)
+		}
+	}
+}
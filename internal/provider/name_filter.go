@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	cmStringValidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameFilterAttributes returns the optional attributes used by plural data sources to filter entities by name.
+func nameFilterAttributes(entities string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name_prefix": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Return only %s whose name starts with the given prefix.", entities),
+			Optional:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Return only %s whose name matches the given regular expression.", entities),
+			Optional:            true,
+			Validators: []validator.String{
+				cmStringValidators.ValidRegex(),
+			},
+		},
+	}
+}
+
+type nameFilter struct {
+	prefix string
+	regex  *regexp.Regexp
+}
+
+func newNameFilter(prefix types.String, regex types.String, diagnostics *diag.Diagnostics) *nameFilter {
+	retVal := &nameFilter{prefix: prefix.ValueString()}
+
+	if regex.ValueString() != "" {
+		r, err := regexp.Compile(regex.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("name_regex"), validationError, fmt.Sprintf("Invalid regular expression: %s", err))
+			return nil
+		}
+
+		retVal.regex = r
+	}
+
+	return retVal
+}
+
+func (f *nameFilter) matches(name *string) bool {
+	n := controlmonkey.StringValue(name)
+
+	if !strings.HasPrefix(n, f.prefix) {
+		return false
+	}
+
+	return f.regex == nil || f.regex.MatchString(n)
+}
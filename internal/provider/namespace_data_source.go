@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfNamespace "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/namespace_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -27,23 +28,29 @@ func (r *NamespaceDataSource) Metadata(_ context.Context, req datasource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_namespace"
 }
 
-func (r *NamespaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The unique ID of the namespace.",
-				Optional:            true,
-				Validators: []validator.String{
-					stringvalidator.AtLeastOneOf(
-						path.MatchRoot("id"), path.MatchRoot("name")),
-				},
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the namespace.",
-				Optional:            true,
-			},
+func (r *NamespaceDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := resourceComputedAttributes(ctx, NewNamespaceResource(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The namespace is looked up by its ID or name.
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique ID of the namespace.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AtLeastOneOf(
+				path.MatchRoot("id"), path.MatchRoot("name")),
 		},
 	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the namespace.",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
 }
 
 // Configure adds the provider configured client to the data source.
@@ -69,6 +76,11 @@ func (r *NamespaceDataSource) Configure(_ context.Context, req datasource.Config
 
 // Read refreshes the Terraform state with the latest data.
 func (r *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Get current state
 	var state tfNamespace.ResourceModel
 
@@ -82,7 +94,7 @@ func (r *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	res, err := r.client.Client.namespace.ListNamespaces(ctx, namespaceId, name)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read namespace"), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read namespace"), "", err)...)
 		return
 	} else if len(res) == 0 {
 		resp.Diagnostics.AddError(fmt.Sprintf(resourceNotFoundError), fmt.Sprintf(namespaceNotFoundError))
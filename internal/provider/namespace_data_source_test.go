@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -15,7 +16,23 @@ func TestAccNamespaceDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "namespace"{
-  name = "Namespace Unique"
+  name = "`+test_helpers.NamePrefix+`Namespace Unique"
+
+  iac_config = {
+    terraform_version = "1.5.0"
+  }
+
+  runner_config = {
+    mode           = "managed"
+    is_overridable = false
+  }
+
+  capabilities = {
+    drift_detection = {
+      status         = "enabled"
+      is_overridable = true
+    }
+  }
 }
 
 data "cm_namespace" "namespace" {
@@ -23,7 +40,12 @@ data "cm_namespace" "namespace" {
 }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_namespace.namespace", "id"),
-					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "name", "Namespace Unique"),
+					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "name", test_helpers.NamePrefix+"Namespace Unique"),
+					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "iac_config.terraform_version", "1.5.0"),
+					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "runner_config.mode", "managed"),
+					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "runner_config.is_overridable", "false"),
+					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "capabilities.drift_detection.status", "enabled"),
+					resource.TestCheckResourceAttr("data.cm_namespace.namespace", "capabilities.drift_detection.is_overridable", "true"),
 				),
 			},
 		},
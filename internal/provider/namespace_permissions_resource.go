@@ -28,6 +28,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &NamespacePermissionsResource{}
+var _ resource.ResourceWithModifyPlan = &NamespacePermissionsResource{}
 
 func NewNamespacePermissionsResource() resource.Resource {
 	return &NamespacePermissionsResource{}
@@ -41,7 +42,7 @@ func (r *NamespacePermissionsResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_namespace_permissions"
 }
 
-func (r *NamespacePermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NamespacePermissionsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys namespace permissions.",
 		Attributes: map[string]schema.Attribute{
@@ -122,6 +123,9 @@ func (r *NamespacePermissionsResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -180,8 +184,26 @@ func (r *NamespacePermissionsResource) ValidateConfig(ctx context.Context, req r
 	}
 }
 
+// ModifyPlan fails when the namespace permissions is planned in a namespace that is not allowed by the provider configuration.
+func (r *NamespacePermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.client.hasNamespaceRestrictions() {
+		return
+	}
+
+	var plan tfNamespacePermissions.ResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), plan.NamespaceId)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *NamespacePermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state tfNamespacePermissions.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -190,6 +212,12 @@ func (r *NamespacePermissionsResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.namespacePermissions.ListNamespacePermissions(ctx, id)
 	if err != nil {
@@ -199,7 +227,7 @@ func (r *NamespacePermissionsResource) Read(ctx context.Context, req resource.Re
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read namespace permissions for namespace '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read namespace permissions for namespace '%s'", id), "", err)...)
 		return
 	}
 
@@ -215,6 +243,12 @@ func (r *NamespacePermissionsResource) Read(ctx context.Context, req resource.Re
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *NamespacePermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfNamespacePermissions.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -223,6 +257,12 @@ func (r *NamespacePermissionsResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := tfNamespacePermissions.Merge(&plan, nil, commons.CreateMerger)
 	namespaceId := plan.NamespaceId
 
@@ -243,6 +283,12 @@ func (r *NamespacePermissionsResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NamespacePermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfNamespacePermissions.ResourceModel
 	var state tfNamespacePermissions.ResourceModel
@@ -256,6 +302,12 @@ func (r *NamespacePermissionsResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := tfNamespacePermissions.Merge(&plan, &state, commons.UpdateMerger)
 
 	diags = r.deleteEntities(ctx, mergeResult.EntitiesToDelete, plan.NamespaceId.ValueString())
@@ -282,6 +334,12 @@ func (r *NamespacePermissionsResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NamespacePermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfNamespacePermissions.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -290,6 +348,12 @@ func (r *NamespacePermissionsResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := tfNamespacePermissions.Merge(nil, &state, commons.DeleteMerger)
 
 	diags = r.deleteEntities(ctx, mergeResult.EntitiesToDelete, state.NamespaceId.ValueString())
@@ -312,15 +376,9 @@ func (r *NamespacePermissionsResource) createEntities(ctx context.Context, entit
 
 		if err != nil {
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to add permission '%s' to namespace '%s'. Error: %s",
-						beautyStringifyApi(e), namespaceId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to add permission '%s' to namespace '%s'", beautyStringifyApi(e), namespaceId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to add permission '%s' to namespace '%s'", beautyStringifyApi(e), namespaceId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to add permission '%s' to namespace '%s'", beautyStringifyApi(e), namespaceId), "", err)
 			}
 		}
 	}
@@ -344,15 +402,9 @@ func (r *NamespacePermissionsResource) deleteEntities(ctx context.Context, entit
 
 		if err != nil {
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to remove permission '%s' from namespace '%s'. Error: %s",
-						beautyStringifyApi(e), namespaceId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to remove permission '%s' from namespace '%s'", beautyStringifyApi(e), namespaceId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to remove permission '%s' from namespace '%s'", beautyStringifyApi(e), namespaceId),
-						err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to remove permission '%s' from namespace '%s'", beautyStringifyApi(e), namespaceId), "", err)
 			}
 		}
 	}
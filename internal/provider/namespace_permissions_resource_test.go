@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -19,7 +20,7 @@ const (
 func testAccNamespacePermissionsResourceSetup() string {
 	return `
 resource "cm_namespace" "test_namespace" {
-  name = "TestNamespace"
+  name = "` + test_helpers.NamePrefix + `TestNamespace"
 }
 `
 }
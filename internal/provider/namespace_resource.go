@@ -6,12 +6,16 @@ import (
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkNamespace "github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/cross_schema"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/namespace"
+	cm_objectvalidator "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/object"
+	cmStringValidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +27,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &NamespaceResource{}
+var _ resource.ResourceWithModifyPlan = &NamespaceResource{}
 
 func NewNamespaceResource() resource.Resource {
 	return &NamespaceResource{}
@@ -36,7 +41,7 @@ func (r *NamespaceResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_namespace"
 }
 
-func (r *NamespaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NamespaceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys namespaces. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/administration/namespaces)",
 		Attributes: map[string]schema.Attribute{
@@ -89,14 +94,23 @@ func (r *NamespaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					"terraform_version": schema.StringAttribute{
 						MarkdownDescription: "the Terraform version that will be used for terraform operations.",
 						Optional:            true,
+						Validators: []validator.String{
+							cmStringValidators.IacVersion(),
+						},
 					},
 					"terragrunt_version": schema.StringAttribute{
 						MarkdownDescription: "the Terragrunt version that will be used for terragrunt operations.",
 						Optional:            true,
+						Validators: []validator.String{
+							cmStringValidators.IacVersion(),
+						},
 					},
 					"opentofu_version": schema.StringAttribute{
 						MarkdownDescription: "the OpenTofu version that will be used for tofu operations.",
 						Optional:            true,
+						Validators: []validator.String{
+							cmStringValidators.IacVersion(),
+						},
 					},
 				},
 			},
@@ -122,6 +136,9 @@ func (r *NamespaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 						Required:            true,
 					},
 				},
+				Validators: []validator.Object{
+					cm_objectvalidator.RunnerConfig(),
+				},
 			},
 			"deployment_approval_policy": schema.SingleNestedAttribute{
 				MarkdownDescription: "Set up requirements to approve a deployment",
@@ -158,6 +175,11 @@ func (r *NamespaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			deletionProtectionAttributeName: deletionProtectionAttribute("namespace"),
+			adoptExistingAttributeName:      adoptExistingAttribute("namespace", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -221,39 +243,28 @@ func (r *NamespaceResource) ValidateConfig(ctx context.Context, req resource.Val
 		}
 	}
 
-	runnerConfig := data.RunnerConfig
-
-	if runnerConfig != nil {
-		mode := runnerConfig.Mode
-
-		if helpers.IsKnown(mode) {
-			modeValue := mode.ValueString()
-
-			if modeValue == cmTypes.Managed && runnerConfig.Groups.IsNull() == false {
-				resp.Diagnostics.AddError(
-					validationError, fmt.Sprintf("runner_config.mode with type '%s' cannot have runner_config.groups", cmTypes.Managed),
-				)
-			} else if modeValue == cmTypes.SelfHosted && helpers.IsKnown(runnerConfig.Groups) {
-				if len(runnerConfig.Groups.Elements()) == 0 {
-					resp.Diagnostics.AddError(
-						validationError, fmt.Sprintf("runner_config.mode with type '%s' requires runner_config.groups to be not empty", cmTypes.SelfHosted),
-					)
-				} else if helpers.DoesTfListContainsEmptyValue(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found empty string in runner_config.groups",
-					)
-				} else if !helpers.IsTfStringSliceUnique(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found duplicate in runner_config.groups",
-					)
-				}
-			}
+	if data.IacConfig != nil {
+		versions := iacVersions{
+			TerraformVersion:  data.IacConfig.TerraformVersion,
+			TerragruntVersion: data.IacConfig.TerragruntVersion,
+			OpentofuVersion:   data.IacConfig.OpentofuVersion,
 		}
+
+		resp.Diagnostics.Append(r.client.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions)...)
 	}
 }
 
+// ModifyPlan fails when a namespace with deletion protection would be destroyed or replaced.
+func (r *NamespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(deletionProtectionPlanDiagnostics(ctx, "namespace", req)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state namespace.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -262,6 +273,12 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.namespace.ReadNamespace(ctx, id)
 	if err != nil {
@@ -270,7 +287,7 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read namespace %s", id), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read namespace %s", id), "", err)...)
 		return
 	}
 
@@ -286,6 +303,12 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan namespace.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -294,14 +317,31 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := namespace.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.namespace.CreateNamespace(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Namespace creation failed",
-			fmt.Sprintf("failed to create namespace, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, "Namespace creation failed", "failed to create namespace", err)...)
 		return
 	}
 
@@ -316,6 +356,12 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan namespace.ResourceModel
 	var state namespace.ResourceModel
@@ -329,6 +375,12 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := namespace.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -338,12 +390,10 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 			resp.Diagnostics.AddError(resourceNotFoundError, fmt.Sprintf("Namespace '%s' not found", id))
 			return
 		}
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update namespace %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update namespace %s", id), err)...)
 		return
 	}
+	r.client.effectiveSettings.forgetNamespace(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -354,6 +404,12 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state namespace.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -362,8 +418,19 @@ func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
+	resp.Diagnostics.Append(deletionProtectionDeleteDiagnostics("namespace", id, state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.Client.namespace.DeleteNamespace(ctx, id)
 
 	if err != nil {
@@ -372,10 +439,7 @@ func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteReque
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Namespace deletion failed",
-			fmt.Sprintf("Failed to delete namespace %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Namespace deletion failed", fmt.Sprintf("Failed to delete namespace %s", id), err)...)
 		return
 	}
 }
@@ -386,4 +450,37 @@ func (r *NamespaceResource) ImportState(ctx context.Context, req resource.Import
 
 //region Private
 
+// adoptExisting takes ownership of an existing namespace with the planned name and applies the plan to it. It returns
+// false when there is no such namespace.
+func (r *NamespaceResource) adoptExisting(ctx context.Context, plan *namespace.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkNamespace.Namespace]{
+		Kind: "namespace",
+		List: func(ctx context.Context) ([]*sdkNamespace.Namespace, error) {
+			return r.client.Client.namespace.ListNamespaces(ctx, nil, plan.Name.ValueStringPointer())
+		},
+		Match: func(e *sdkNamespace.Namespace) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkNamespace.Namespace) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.namespace.ReadNamespace,
+		Update: func(ctx context.Context, id string, existing *sdkNamespace.Namespace) error {
+			var state namespace.ResourceModel
+			namespace.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := namespace.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.namespace.UpdateNamespace(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
 //endregion
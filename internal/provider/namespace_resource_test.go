@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
@@ -13,10 +14,10 @@ const (
 	cmNamespace = "cm_namespace"
 
 	n1ResourceName = "namespace1"
-	n1Name         = "namespace1"
+	n1Name         = test_helpers.NamePrefix + "namespace1"
 	n1Description  = "first namespace test"
 
-	n1NameAfterUpdate = "namespace2"
+	n1NameAfterUpdate = test_helpers.NamePrefix + "namespace2"
 )
 
 func TestAccNamespaceResourceNamespace(t *testing.T) {
@@ -43,11 +44,11 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-  name = "Namespace Test 1"
+  name = "`+test_helpers.NamePrefix+`Namespace Test 1"
 }
 
 resource "cm_team" "team2" {
-  name = "Namespace Test 2"
+  name = "`+test_helpers.NamePrefix+`Namespace Test 2"
 }
 
 resource "%s" "%s" {
@@ -85,11 +86,11 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team1" {
-  name = "Namespace Test 1"
+  name = "`+test_helpers.NamePrefix+`Namespace Test 1"
 }
 
 resource "cm_team" "team2" {
-  name = "Namespace Test 2"
+  name = "`+test_helpers.NamePrefix+`Namespace Test 2"
 }
 
 resource "%s" "%s" {
@@ -167,6 +168,40 @@ resource "%s" "%s" {
 	})
 }
 
+func TestAccNamespaceResourceDeletionProtection(t *testing.T) {
+	protectedConfig := func(deletionProtection bool) string {
+		return providerConfig + fmt.Sprintf(`
+resource "%s" "%s" {
+  name = "%s"
+  deletion_protection = %t
+}
+`, cmNamespace, n1ResourceName, n1Name, deletionProtection)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: protectedConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(namespaceResourceName(n1ResourceName), "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      providerConfig,
+				ExpectError: regexp.MustCompile("Deletion protection is enabled"),
+			},
+			{
+				Config: protectedConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(namespaceResourceName(n1ResourceName), "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func namespaceResourceName(s string) string {
 	return fmt.Sprintf("%s.%s", cmNamespace, s)
 }
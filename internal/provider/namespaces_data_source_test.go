@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamespacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "cm_namespace" "namespace" {
+  name = "`+test_helpers.NamePrefix+`Namespaces Data Source Unique"
+
+  iac_config = {
+    terraform_version = "1.5.0"
+  }
+}
+data "cm_namespaces" "namespaces" {
+  name_prefix = "`+test_helpers.NamePrefix+`Namespaces Data Source"
+
+  depends_on = [cm_namespace.namespace]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_namespaces.namespaces", "namespaces.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_namespaces.namespaces", "namespaces.0.id"),
+					resource.TestCheckResourceAttr("data.cm_namespaces.namespaces", "namespaces.0.name", test_helpers.NamePrefix+"Namespaces Data Source Unique"),
+					resource.TestCheckResourceAttr("data.cm_namespaces.namespaces", "namespaces.0.iac_config.terraform_version", "1.5.0"),
+				),
+			},
+		},
+	})
+}
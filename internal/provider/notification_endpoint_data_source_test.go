@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -28,7 +29,7 @@ variable "slack_url" {
 }
 
 resource "cm_notification_endpoint" "notification_endpoint" {
-  name = "Notification Endpoint Unique"
+  name = "`+test_helpers.NamePrefix+`Notification Endpoint Unique"
   protocol = "slack"
   url = var.slack_url
 }
@@ -39,7 +40,7 @@ data "cm_notification_endpoint" "notification_endpoint" {
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_notification_endpoint.notification_endpoint", "id"),
-					resource.TestCheckResourceAttr("data.cm_notification_endpoint.notification_endpoint", "name", "Notification Endpoint Unique"),
+					resource.TestCheckResourceAttr("data.cm_notification_endpoint.notification_endpoint", "name", test_helpers.NamePrefix+"Notification Endpoint Unique"),
 				),
 			},
 		},
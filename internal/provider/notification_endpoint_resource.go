@@ -6,11 +6,13 @@ import (
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkNotification "github.com/control-monkey/controlmonkey-sdk-go/services/notification"
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfNotificationEndpoint "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/notification_endpoint"
 	cmStringValidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -35,7 +37,7 @@ func (r *NotificationEndpointResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_notification_endpoint"
 }
 
-func (r *NotificationEndpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationEndpointResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys notification endpoints. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/administration/notifications)",
 		Attributes: map[string]schema.Attribute{
@@ -93,6 +95,10 @@ func (r *NotificationEndpointResource) Schema(_ context.Context, _ resource.Sche
 					},
 				},
 			},
+			adoptExistingAttributeName: adoptExistingAttribute("notification endpoint", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -164,6 +170,10 @@ func (r *NotificationEndpointResource) Configure(_ context.Context, req resource
 
 // Read refreshes the Terraform state with the latest data.
 func (r *NotificationEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state tfNotificationEndpoint.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -172,6 +182,12 @@ func (r *NotificationEndpointResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.notification.ReadNotificationEndpoint(ctx, id)
 	if err != nil {
@@ -180,7 +196,7 @@ func (r *NotificationEndpointResource) Read(ctx context.Context, req resource.Re
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read notification endpoint '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read notification endpoint '%s'", id), "", err)...)
 		return
 	}
 
@@ -196,6 +212,12 @@ func (r *NotificationEndpointResource) Read(ctx context.Context, req resource.Re
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *NotificationEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan tfNotificationEndpoint.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -204,14 +226,31 @@ func (r *NotificationEndpointResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := tfNotificationEndpoint.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.notification.CreateNotificationEndpoint(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("failed to create notification endpoint, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create notification endpoint", err)...)
 		return
 	}
 
@@ -226,6 +265,12 @@ func (r *NotificationEndpointResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NotificationEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfNotificationEndpoint.ResourceModel
 	var state tfNotificationEndpoint.ResourceModel
@@ -239,6 +284,12 @@ func (r *NotificationEndpointResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfNotificationEndpoint.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -249,9 +300,7 @@ func (r *NotificationEndpointResource) Update(ctx context.Context, req resource.
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError, fmt.Sprintf("failed to update notification endpoint %s, error: %s", id, err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update notification endpoint %s", id), err)...)
 		return
 	}
 
@@ -264,6 +313,12 @@ func (r *NotificationEndpointResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NotificationEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfNotificationEndpoint.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -272,6 +327,12 @@ func (r *NotificationEndpointResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.notification.DeleteNotificationEndpoint(ctx, id)
 
@@ -281,10 +342,7 @@ func (r *NotificationEndpointResource) Delete(ctx context.Context, req resource.
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete notification endpoint %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete notification endpoint %s", id), err)...)
 		return
 	}
 }
@@ -292,3 +350,40 @@ func (r *NotificationEndpointResource) Delete(ctx context.Context, req resource.
 func (r *NotificationEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing notification endpoint with the planned name and applies the plan to it. It returns
+// false when there is no such notification endpoint.
+func (r *NotificationEndpointResource) adoptExisting(ctx context.Context, plan *tfNotificationEndpoint.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkNotification.Endpoint]{
+		Kind: "notification endpoint",
+		List: func(ctx context.Context) ([]*sdkNotification.Endpoint, error) {
+			return r.client.Client.notification.ListNotificationEndpoints(ctx, nil, plan.Name.ValueStringPointer())
+		},
+		Match: func(e *sdkNotification.Endpoint) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkNotification.Endpoint) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.notification.ReadNotificationEndpoint,
+		Update: func(ctx context.Context, id string, existing *sdkNotification.Endpoint) error {
+			var state tfNotificationEndpoint.ResourceModel
+			tfNotificationEndpoint.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := tfNotificationEndpoint.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.notification.UpdateNotificationEndpoint(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
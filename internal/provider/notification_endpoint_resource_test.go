@@ -15,11 +15,11 @@ const (
 	cmNotificationEndpoint = "cm_notification_endpoint"
 
 	notificationEndpointResourceName = "notificationEndpoint"
-	notificationEndpointName         = "Dev Endpoint"
+	notificationEndpointName         = test_helpers.NamePrefix + "Dev Endpoint"
 	notificationEndpointProtocol     = cmTypes.SlackProtocol
 	notificationEndpointUrl          = "https://hooks.slack.com"
 
-	notificationEndpointNameAfterUpdate = "Prod notificationEndpoint"
+	notificationEndpointNameAfterUpdate = test_helpers.NamePrefix + "Prod notificationEndpoint"
 )
 
 func TestAccNotificationEndpointResource(t *testing.T) {
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationEndpointsDataSource(t *testing.T) {
+	// Test environment variables used by this function
+	slackWebhookUrl := test_config.GetSlackWebhookUrl()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				ConfigVariables: config.Variables{
+					"slack_url": config.StringVariable(slackWebhookUrl),
+				},
+				Config: providerConfig + fmt.Sprintf(`
+variable "slack_url" {
+  type = string
+}
+
+resource "cm_notification_endpoint" "notification_endpoint" {
+  name     = "`+test_helpers.NamePrefix+`Notification Endpoints Data Source Unique"
+  protocol = "slack"
+  url      = var.slack_url
+}
+data "cm_notification_endpoints" "notification_endpoints" {
+  name_prefix = "`+test_helpers.NamePrefix+`Notification Endpoints Data Source"
+
+  depends_on = [cm_notification_endpoint.notification_endpoint]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_notification_endpoints.notification_endpoints", "notification_endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_notification_endpoints.notification_endpoints", "notification_endpoints.0.id"),
+					resource.TestCheckResourceAttr("data.cm_notification_endpoints.notification_endpoints", "notification_endpoints.0.name", test_helpers.NamePrefix+"Notification Endpoints Data Source Unique"),
+					resource.TestCheckResourceAttr("data.cm_notification_endpoints.notification_endpoints", "notification_endpoints.0.protocol", "slack"),
+				),
+			},
+		},
+	})
+}
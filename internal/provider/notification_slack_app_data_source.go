@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfSlackAppData "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/notification_slack_app_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -66,6 +67,11 @@ func (r *NotificationSlackAppDataSource) Configure(_ context.Context, req dataso
 }
 
 func (r *NotificationSlackAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state tfSlackAppData.ResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -76,7 +82,7 @@ func (r *NotificationSlackAppDataSource) Read(ctx context.Context, req datasourc
 	name := state.Name.ValueStringPointer()
 	res, err := r.client.Client.notification.ListNotificationSlackApps(ctx, appId, name)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read notification slack app", fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to read notification slack app", "", err)...)
 		return
 	}
 	if len(res) == 0 {
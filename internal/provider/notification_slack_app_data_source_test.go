@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -20,7 +21,7 @@ func TestAccNotificationSlackAppDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_notification_slack_app" "slack_app" {
-  name = "Unique Slack App Name 123"
+  name = "`+test_helpers.NamePrefix+`Unique Slack App Name 123"
   bot_auth_token = "xoxb-***"
 }
 `),
@@ -28,7 +29,7 @@ resource "cm_notification_slack_app" "slack_app" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_notification_slack_app" "slack_app" {
-  name = "Unique Slack App Name 123"
+  name = "`+test_helpers.NamePrefix+`Unique Slack App Name 123"
   bot_auth_token = "xoxb-***"
 }
 
@@ -44,7 +45,7 @@ data "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_notification_slack_app" "slack_app" {
-  name = "Unique Slack App Name 123"
+  name = "`+test_helpers.NamePrefix+`Unique Slack App Name 123"
   bot_auth_token = "xoxb-***"
 }
 
@@ -26,7 +26,7 @@ func (r *NotificationSlackAppResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_notification_slack_app"
 }
 
-func (r *NotificationSlackAppResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationSlackAppResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys Slack App notifications integration. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/administration/notifications/creating-a-slack-app)",
 		Attributes: map[string]schema.Attribute{
@@ -46,6 +46,9 @@ func (r *NotificationSlackAppResource) Schema(_ context.Context, _ resource.Sche
 				Sensitive:           true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -71,16 +74,26 @@ func (r *NotificationSlackAppResource) Configure(_ context.Context, req resource
 }
 
 func (r *NotificationSlackAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	var state tfSlackApp.ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.notification.ListNotificationSlackApps(ctx, &id, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read notification slack app", err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to read notification slack app", "", err)...)
 		return
 	}
 	if len(res) == 0 {
@@ -96,16 +109,28 @@ func (r *NotificationSlackAppResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *NotificationSlackAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan tfSlackApp.ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := tfSlackApp.Converter(&plan, nil, commons.CreateConverter)
 	res, err := r.client.Client.notification.CreateNotificationSlackApp(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(resourceCreationFailedError, fmt.Sprintf("failed to create notification slack app, error: %s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create notification slack app", err)...)
 		return
 	}
 
@@ -118,6 +143,12 @@ func (r *NotificationSlackAppResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NotificationSlackAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan tfSlackApp.ResourceModel
 	var state tfSlackApp.ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -126,6 +157,12 @@ func (r *NotificationSlackAppResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	body, _ := tfSlackApp.Converter(&plan, &state, commons.UpdateConverter)
 	_, err := r.client.Client.notification.UpdateNotificationSlackApp(ctx, id, body)
@@ -134,7 +171,7 @@ func (r *NotificationSlackAppResource) Update(ctx context.Context, req resource.
 			resp.Diagnostics.AddError(resourceNotFoundError, "Notification Slack App not found")
 			return
 		}
-		resp.Diagnostics.AddError(resourceUpdateFailedError, fmt.Sprintf("failed to update notification slack app, error: %s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, "failed to update notification slack app", err)...)
 		return
 	}
 
@@ -145,18 +182,30 @@ func (r *NotificationSlackAppResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NotificationSlackAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state tfSlackApp.ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	id := state.ID.ValueString()
 	if _, err := r.client.Client.notification.DeleteNotificationSlackApp(ctx, id); err != nil {
 		if commons.IsNotFoundResponseError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(resourceDeletionFailedError, fmt.Sprintf("Failed to delete notification slack app, error: %s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, "Failed to delete notification slack app", err)...)
 		return
 	}
 }
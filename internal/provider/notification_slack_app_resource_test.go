@@ -12,8 +12,8 @@ import (
 const (
 	cmNotificationSlackApp  = "cm_notification_slack_app"
 	slackAppTfResourceName  = "slack_app"
-	slackAppName            = "tf-acc-slack-app"
-	slackAppNameAfterUpdate = "tf-acc-slack-app-updated"
+	slackAppName            = test_helpers.NamePrefix + "slack-app"
+	slackAppNameAfterUpdate = test_helpers.NamePrefix + "slack-app-updated"
 )
 
 func TestAccNotificationSlackAppResource(t *testing.T) {
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	tfOrgConfiguration "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/org_configuration_data"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &OrgConfigurationDataSource{}
+
+func NewOrgConfigurationDataSource() datasource.DataSource {
+	return &OrgConfigurationDataSource{}
+}
+
+type OrgConfigurationDataSource struct {
+	client *ControlMonkeyAPIClient
+}
+
+func (r *OrgConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_configuration"
+}
+
+func (r *OrgConfigurationDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the configuration of the organization, such as the default IaC versions, runner mode and state files locations.",
+		Attributes:          resourceComputedAttributes(ctx, NewOrgConfigurationResource(), &resp.Diagnostics),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *OrgConfigurationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ControlMonkeyAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ControlMonkeyAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OrgConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state tfOrgConfiguration.ResourceModel
+
+	res, err := r.client.Client.organization.ReadOrgConfiguration(ctx)
+	if err != nil {
+		if commons.IsNotFoundResponseError(err) {
+			resp.Diagnostics.AddError(resourceNotFoundError, orgConfigurationNotFoundError)
+			return
+		}
+
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to read org configuration", "", err)...)
+		return
+	}
+
+	tfOrgConfiguration.UpdateStateAfterRead(res, &state)
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
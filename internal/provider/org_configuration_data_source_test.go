@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgConfigurationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "cm_org_configuration" "org_configuration" {
+  iac_config = {
+    terraform_version = "1.5.0"
+  }
+
+  runner_config = {
+    mode           = "managed"
+    is_overridable = true
+  }
+}
+
+data "cm_org_configuration" "org_configuration" {
+  depends_on = [cm_org_configuration.org_configuration]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.cm_org_configuration.org_configuration", "id"),
+					resource.TestCheckResourceAttr("data.cm_org_configuration.org_configuration", "iac_config.terraform_version", "1.5.0"),
+					resource.TestCheckResourceAttr("data.cm_org_configuration.org_configuration", "runner_config.mode", "managed"),
+					resource.TestCheckResourceAttr("data.cm_org_configuration.org_configuration", "runner_config.is_overridable", "true"),
+				),
+			},
+		},
+	})
+}
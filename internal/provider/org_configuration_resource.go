@@ -8,6 +8,7 @@ import (
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfOrgConfiguration "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/org_configuration"
+	cm_objectvalidator "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/object"
 	cmStringValidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -35,7 +37,7 @@ func (r *OrgConfigurationResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_org_configuration"
 }
 
-func (r *OrgConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *OrgConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys org configuration.",
 		Attributes: map[string]schema.Attribute{
@@ -55,6 +57,7 @@ func (r *OrgConfigurationResource) Schema(_ context.Context, _ resource.SchemaRe
 						Optional:            true,
 						Validators: []validator.String{
 							cmStringValidators.NotBlank(),
+							cmStringValidators.IacVersion(),
 						},
 					},
 					"terragrunt_version": schema.StringAttribute{
@@ -62,6 +65,7 @@ func (r *OrgConfigurationResource) Schema(_ context.Context, _ resource.SchemaRe
 						Optional:            true,
 						Validators: []validator.String{
 							cmStringValidators.NotBlank(),
+							cmStringValidators.IacVersion(),
 						},
 					},
 					"opentofu_version": schema.StringAttribute{
@@ -69,6 +73,7 @@ func (r *OrgConfigurationResource) Schema(_ context.Context, _ resource.SchemaRe
 						Optional:            true,
 						Validators: []validator.String{
 							cmStringValidators.NotBlank(),
+							cmStringValidators.IacVersion(),
 						},
 					},
 				},
@@ -95,6 +100,9 @@ func (r *OrgConfigurationResource) Schema(_ context.Context, _ resource.SchemaRe
 						Optional:            true,
 					},
 				},
+				Validators: []validator.Object{
+					cm_objectvalidator.RunnerConfig(),
+				},
 			},
 			"s3_state_files_locations": schema.ListNestedAttribute{
 				MarkdownDescription: "The S3 buckets of your current terraform state files. This will be used by ControlMonkey to scan for existing managed resources.",
@@ -244,6 +252,10 @@ func (r *OrgConfigurationResource) Schema(_ context.Context, _ resource.SchemaRe
 					},
 				},
 			},
+			adoptExistingAttributeName: adoptExistingAttribute("org configuration", "of the organization"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -269,8 +281,30 @@ func (r *OrgConfigurationResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
+func (r *OrgConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data tfOrgConfiguration.ResourceModel
+
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	if data.IacConfig != nil {
+		versions := iacVersions{
+			TerraformVersion:  data.IacConfig.TerraformVersion,
+			TerragruntVersion: data.IacConfig.TerragruntVersion,
+			OpentofuVersion:   data.IacConfig.OpentofuVersion,
+		}
+
+		resp.Diagnostics.Append(r.client.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions)...)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *OrgConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state tfOrgConfiguration.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -279,6 +313,12 @@ func (r *OrgConfigurationResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.client.Client.organization.ReadOrgConfiguration(ctx)
 	if err != nil {
 		if commons.IsNotFoundResponseError(err) {
@@ -286,7 +326,7 @@ func (r *OrgConfigurationResource) Read(ctx context.Context, req resource.ReadRe
 			return
 		}
 
-		resp.Diagnostics.AddError("Failed to read org configuration", err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Failed to read org configuration", "", err)...)
 		return
 	}
 
@@ -302,30 +342,45 @@ func (r *OrgConfigurationResource) Read(ctx context.Context, req resource.ReadRe
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *OrgConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	//only a single org config must exist. So, before creating a new one, we check if one is already exists
-	diags := r.checkIfExistsBeforeCreate(ctx)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	//Retrieve values from plan
 	var plan tfOrgConfiguration.ResourceModel
-	diags = req.Plan.Get(ctx, &plan)
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//only a single org config must exist. So, before creating a new one, we check if one is already exists
+	existing, diags := r.checkIfExistsBeforeCreate(ctx, r.client.shouldAdoptExisting(plan.AdoptExisting))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	body, _ := tfOrgConfiguration.Converter(&plan, nil, commons.CreateConverter)
+	converterType := commons.CreateConverter
+	if existing != nil {
+		converterType = commons.UpdateConverter
+	}
+
+	body, _ := tfOrgConfiguration.Converter(&plan, existing, converterType)
 
 	if _, err := r.client.Client.organization.UpsertOrgConfiguration(ctx, body); err != nil {
-		resp.Diagnostics.AddError(
-			resourceCreationFailedError,
-			fmt.Sprintf("Failed to create org configuration, error: %s", err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "Failed to create org configuration", err)...)
 		return
 	}
+	r.client.effectiveSettings.forgetOrgConfiguration()
 
 	plan.ID = types.StringValue(tfOrgConfiguration.ImportID)
 
@@ -338,23 +393,39 @@ func (r *OrgConfigurationResource) Create(ctx context.Context, req resource.Crea
 
 }
 
-func (r *OrgConfigurationResource) checkIfExistsBeforeCreate(ctx context.Context) diag.Diagnostics {
+// checkIfExistsBeforeCreate fails when an org configuration already exists, unless it should be adopted. In that case
+// the existing configuration is returned, so only the planned changes are applied to it.
+func (r *OrgConfigurationResource) checkIfExistsBeforeCreate(ctx context.Context, adoptExisting bool) (*tfOrgConfiguration.ResourceModel, diag.Diagnostics) {
 	retVal := diag.Diagnostics{}
 
 	res, err := r.client.Client.organization.ReadOrgConfiguration(ctx)
 
 	if err != nil {
-		retVal.AddError(resourceCreationFailedError, fmt.Sprintf("Failed to create org configuration. Error: %s", err))
+		retVal.Append(commons.ApiErrorDiagnostics(ctx, resourceCreationFailedError, "Failed to create org configuration", err)...)
 	} else if !helpers.IsAllNilFields(res) {
+		if adoptExisting {
+			var existing tfOrgConfiguration.ResourceModel
+			tfOrgConfiguration.UpdateStateAfterRead(res, &existing)
+			tflog.Info(ctx, "Adopted existing org configuration")
+
+			return &existing, retVal
+		}
+
 		retVal.AddError("Org Configuration already exists, there is only one configuration allowed per organization",
-			fmt.Sprintf("Import operation is required to manage this resourcce. Use import command e.g 'terraform import cm_org_configuration.<resource_name> %s'", tfOrgConfiguration.ImportID),
+			fmt.Sprintf("Import operation is required to manage this resourcce, or set 'adopt_existing = true'. Use import command e.g 'terraform import cm_org_configuration.<resource_name> %s'", tfOrgConfiguration.ImportID),
 		)
 	}
 
-	return retVal
+	return nil, retVal
 }
 
 func (r *OrgConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan tfOrgConfiguration.ResourceModel
 	var state tfOrgConfiguration.ResourceModel
@@ -368,6 +439,12 @@ func (r *OrgConfigurationResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := tfOrgConfiguration.Converter(&plan, &state, commons.UpdateConverter)
 
 	if _, err := r.client.Client.organization.UpsertOrgConfiguration(ctx, body); err != nil {
@@ -375,12 +452,10 @@ func (r *OrgConfigurationResource) Update(ctx context.Context, req resource.Upda
 			resp.Diagnostics.AddError(resourceNotFoundError, "Org Configuration not found")
 			return
 		}
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update org configuration, error: %s", err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, "failed to update org configuration", err)...)
 		return
 	}
+	r.client.effectiveSettings.forgetOrgConfiguration()
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -391,6 +466,12 @@ func (r *OrgConfigurationResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *OrgConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tfOrgConfiguration.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -398,18 +479,22 @@ func (r *OrgConfigurationResource) Delete(ctx context.Context, req resource.Dele
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if _, err := r.client.Client.organization.DeleteOrgConfiguration(ctx); err != nil {
 		if commons.IsNotFoundResponseError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceDeletionFailedError,
-			fmt.Sprintf("Failed to delete org configuration, error: %s", err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, "Failed to delete org configuration", err)...)
 		return
 	}
+	r.client.effectiveSettings.forgetOrgConfiguration()
 }
 
 func (r *OrgConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
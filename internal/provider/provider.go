@@ -2,19 +2,31 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/control-monkey/terraform-provider-cm/version"
 
+	goVersion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/featureflag"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	cm_stringvalidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure ControlMonkeyProvider satisfies various provider interfaces.
@@ -34,12 +46,40 @@ type ControlMonkeyProvider struct {
 }
 
 type ControlMonkeyAPIClient struct {
-	Client *Client
+	Client                *Client
+	AdoptExisting         bool
+	ReadOnly              bool
+	AllowedNamespaceIds   []string
+	AllowedNamespaceNames []string
+	MinimumIacVersions    map[string]*goVersion.Version
+
+	// unknownAttributes holds the errors of the unknown attributes the client was not created for.
+	unknownAttributes diag.Diagnostics
+	// effectiveSettings caches what the effective settings of stacks are resolved against.
+	effectiveSettings *effectiveSettingsCache
 }
 
 // ControlMonkeyProviderModel describes the provider data model.
 type ControlMonkeyProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token                     types.String             `tfsdk:"token"`
+	Endpoint                  types.String             `tfsdk:"endpoint"`
+	Profile                   types.String             `tfsdk:"profile"`
+	SharedCredentialsFile     types.String             `tfsdk:"shared_credentials_file"`
+	SkipCredentialsValidation types.Bool               `tfsdk:"skip_credentials_validation"`
+	FeatureFlags              types.Map                `tfsdk:"feature_flags"`
+	RequestsPerSecond         types.Float64            `tfsdk:"requests_per_second"`
+	Burst                     types.Int64              `tfsdk:"burst"`
+	AdoptExisting             types.Bool               `tfsdk:"adopt_existing"`
+	ReadOnly                  types.Bool               `tfsdk:"read_only"`
+	AllowedNamespaceIds       types.List               `tfsdk:"allowed_namespace_ids"`
+	AllowedNamespaceNames     types.List               `tfsdk:"allowed_namespace_names"`
+	MinimumIacVersions        *MinimumIacVersionsModel `tfsdk:"minimum_iac_versions"`
+}
+
+type MinimumIacVersionsModel struct {
+	TerraformVersion  types.String `tfsdk:"terraform_version"`
+	TerragruntVersion types.String `tfsdk:"terragrunt_version"`
+	OpentofuVersion   types.String `tfsdk:"opentofu_version"`
 }
 
 func (p *ControlMonkeyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,6 +94,88 @@ func (p *ControlMonkeyProvider) Schema(ctx context.Context, req provider.SchemaR
 				MarkdownDescription: "A programmatic user token for ControlMonkey. This can also be set via the `CONTROL_MONKEY_TOKEN` environment variable.",
 				Optional:            true,
 			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The URL of the ControlMonkey API. Takes precedence over the `endpoint` of the shared credentials file. This can also be set via the `%s` environment variable. Defaults to `https://api.controlmonkey.io`.", EndpointEnvVar),
+				Optional:            true,
+				Validators: []validator.String{
+					cm_stringvalidators.NotBlank(),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The profile of the shared credentials file to take the `token` and `endpoint` from. The token of the profile takes precedence over the `CONTROL_MONKEY_TOKEN` environment variable, but not over `token`. Missing values fall back to the `default` profile. This can also be set via the `%s` environment variable.", profileEnvVar),
+				Optional:            true,
+				Validators: []validator.String{
+					cm_stringvalidators.NotBlank(),
+				},
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The path of the shared credentials file. This can also be set via the `%s` environment variable. Defaults to `~/.controlmonkey/credentials` when only `profile` is set, the file is not read when neither is set.", credentials.FileCredentialsEnvVarFile),
+				Optional:            true,
+				Validators: []validator.String{
+					cm_stringvalidators.NotBlank(),
+				},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip validating the credentials by reading the org configuration when the provider is configured. When not skipped, a token the ControlMonkey API rejects as invalid, expired or revoked fails once for the provider instead of for every resource. Other failures of the read, e.g. a role that may not read the org configuration, are left to the resources. Defaults to `false`.",
+				Optional:            true,
+			},
+			"feature_flags": schema.MapAttribute{
+				MarkdownDescription: fmt.Sprintf("Feature flags of this provider instance, e.g. `{ MergeCredentialsChain = true }`. Merged over flags set via the `%s` environment variable, in the form `name=true,other=false`.", featureflag.EnvVar),
+				ElementType:         types.BoolType,
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to the ControlMonkey API by this provider instance. Shared by all resources and data sources. Set it when large configurations exceed the rate limits of the API. Requests are not limited by default, requests rejected with `429 Too Many Requests` are retried after the wait the API asks for either way.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of requests that may be sent at once before `requests_per_second` applies. Only used together with `requests_per_second`. Defaults to `%d`.", defaultBurst),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "When enabled, creating a resource whose entity already exists (e.g. a team or namespace with the same name, or the org configuration) adopts the existing entity and applies the configuration to it, instead of failing. Can be overridden per resource. Defaults to `false`.",
+				Optional:            true,
+			},
+			readOnlyAttributeName: schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("When enabled, every create, update and delete fails before a request is sent to ControlMonkey, while reads and data sources keep working. Useful for audit jobs that must never change anything. This can also be set via the `%s` environment variable. Defaults to `false`.", readOnlyEnvVar),
+				Optional:            true,
+			},
+			"allowed_namespace_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the namespaces this provider may manage namespace-scoped resources in (stacks, variables, namespace permissions, template/blueprint mappings and stack discovery configurations). Planning such a resource in any other namespace fails. Can be combined with `allowed_namespace_names`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          commons.ValidateUniqueNotEmptyListWithNoBlankValues(),
+			},
+			"allowed_namespace_names": schema.ListAttribute{
+				MarkdownDescription: "Names of the namespaces this provider may manage namespace-scoped resources in. Can be combined with `allowed_namespace_ids`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          commons.ValidateUniqueNotEmptyListWithNoBlankValues(),
+			},
+			"minimum_iac_versions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Minimum IaC versions expected in `iac_config` of stacks, templates, namespaces and the org configuration. A warning is shown for every configured version that is lower.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					terraformVersionAttributeName: schema.StringAttribute{
+						MarkdownDescription: "The minimum Terraform version.",
+						Optional:            true,
+					},
+					terragruntVersionAttributeName: schema.StringAttribute{
+						MarkdownDescription: "The minimum Terragrunt version.",
+						Optional:            true,
+					},
+					opentofuVersionAttributeName: schema.StringAttribute{
+						MarkdownDescription: "The minimum OpenTofu version.",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,18 +186,57 @@ func (p *ControlMonkeyProvider) Configure(ctx context.Context, req provider.Conf
 	// Check environment variables
 	token := os.Getenv(credentials.EnvCredentialsVarToken)
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	// The configuration is not known during plan when it refers to other resources, e.g. a token that is created in
+	// the same run. Creating the client is deferred to apply when an attribute it is created with is unknown, any other
+	// unknown attribute is treated as not set until it is known.
+	if unknownAttributes := unknownClientAttributesDiagnostics(ctx, req.Config); unknownAttributes.HasError() {
+		tflog.Info(ctx, "Provider configuration is not known yet, deferring ControlMonkey client creation")
+
+		apiClient := &ControlMonkeyAPIClient{unknownAttributes: unknownAttributes}
+		for _, d := range apiClient.unknownAttributes.Errors() {
+			if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+				resp.Diagnostics.AddAttributeWarning(withPath.Path(), d.Summary(), d.Detail())
+			}
+		}
+
+		resp.DataSourceData = apiClient
+		resp.ResourceData = apiClient
+		return
+	}
+
+	knownConfig, diags := withoutUnknownAttributes(req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(knownConfig.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check configuration data, which should take precedence over
-	// environment variable data, if found.
+	// A selected profile takes precedence over the token in the environment.
+	profile := data.Profile.ValueString()
+	sharedCredentialsFile := data.SharedCredentialsFile.ValueString()
+	profileSelected := profile != "" || sharedCredentialsFile != "" ||
+		os.Getenv(profileEnvVar) != "" || os.Getenv(credentials.FileCredentialsEnvVarProfile) != ""
+
+	if profileSelected {
+		token = ""
+	}
 	if data.Token.ValueString() != "" {
 		token = data.Token.ValueString()
 	}
 
-	if token == "" {
+	endpoint := os.Getenv(EndpointEnvVar)
+	if data.Endpoint.ValueString() != "" {
+		endpoint = data.Endpoint.ValueString()
+	}
+
+	featureFlags := parseFeatureFlags(os.Getenv(featureflag.EnvVar))
+	for name, v := range data.FeatureFlags.Elements() {
+		if enabled, ok := v.(types.Bool); ok && helpers.IsKnown(enabled) {
+			featureFlags[name] = enabled.ValueBool()
+		}
+	}
+
+	if token == "" && !profileSelected {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Missing CONTROL_MONKEY_TOKEN environment variable",
@@ -84,23 +245,99 @@ func (p *ControlMonkeyProvider) Configure(ctx context.Context, req provider.Conf
 		// Not returning early allows the logic to collect all errors.
 	}
 
+	// Requests are not limited unless asked for.
+	requestsPerSecond := float64(0)
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+
+	burst := defaultBurst
+	if !data.Burst.IsNull() {
+		burst = int(data.Burst.ValueInt64())
+	}
+
 	config := Config{
-		Token:            token,
-		FeatureFlags:     os.Getenv(featureflag.EnvVar),
-		terraformVersion: version.Version,
+		Token:                 token,
+		Endpoint:              endpoint,
+		Profile:               profile,
+		SharedCredentialsFile: sharedCredentialsFile,
+		FeatureFlags:          featureFlags,
+		RequestsPerSecond:     requestsPerSecond,
+		Burst:                 burst,
+		terraformVersion:      version.Version,
 	}
 
-	client, err := config.Client()
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Cannot create client with a given token",
-			"Failed to create a client session with a given token",
-		)
+	readOnly := false
+	if v := os.Getenv(readOnlyEnvVar); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(readOnlyAttributeName),
+				fmt.Sprintf("Invalid %s environment variable", readOnlyEnvVar),
+				fmt.Sprintf("%q is not a valid boolean: %s", v, err),
+			)
+		}
+		readOnly = parsed
+	}
+	if !data.ReadOnly.IsNull() {
+		readOnly = data.ReadOnly.ValueBool()
+	}
+
+	minimumIacVersions := make(map[string]*goVersion.Version)
+	if data.MinimumIacVersions != nil {
+		minimums := iacVersions{
+			TerraformVersion:  data.MinimumIacVersions.TerraformVersion,
+			TerragruntVersion: data.MinimumIacVersions.TerragruntVersion,
+			OpentofuVersion:   data.MinimumIacVersions.OpentofuVersion,
+		}
+
+		byAttributeName := minimums.byAttributeName()
+
+		for _, name := range iacVersionAttributeNames {
+			value := byAttributeName[name]
+			if value.IsNull() {
+				continue
+			}
+
+			v, err := goVersion.NewVersion(value.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("minimum_iac_versions").AtName(name),
+					"Invalid minimum IaC version",
+					fmt.Sprintf("%q is not a valid version: %s", value.ValueString(), err),
+				)
+				continue
+			}
+
+			minimumIacVersions[name] = v
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := config.Client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SkipCredentialsValidation.ValueBool() {
+		if err := client.validateCredentials(ctx); err != nil {
+			resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Invalid ControlMonkey credentials", "Failed to validate the credentials of the provider, the token may be invalid, expired or revoked", err)...)
+			return
+		}
 	}
 
 	apiClient := &ControlMonkeyAPIClient{
-		Client: client,
+		Client:                client,
+		AdoptExisting:         data.AdoptExisting.ValueBool(),
+		ReadOnly:              readOnly,
+		AllowedNamespaceIds:   helpers.Map(helpers.TfListToStringSlice(data.AllowedNamespaceIds), controlmonkey.StringValue),
+		AllowedNamespaceNames: helpers.Map(helpers.TfListToStringSlice(data.AllowedNamespaceNames), controlmonkey.StringValue),
+		MinimumIacVersions:    minimumIacVersions,
+		effectiveSettings:     newEffectiveSettingsCache(),
 	}
 
 	resp.DataSourceData = apiClient
@@ -148,5 +385,15 @@ func (p *ControlMonkeyProvider) DataSources(ctx context.Context) []func() dataso
 		NewCustomRoleDataSource,
 		NewCustomAbacConfigurationDataSource,
 		NewNotificationSlackAppDataSource,
+		NewNamespacesDataSource,
+		NewTemplatesDataSource,
+		NewBlueprintsDataSource,
+		NewTeamsDataSource,
+		NewControlPoliciesDataSource,
+		NewControlPolicyGroupsDataSource,
+		NewCustomRolesDataSource,
+		NewNotificationEndpointsDataSource,
+		NewOrgConfigurationDataSource,
+		NewStackDiscoveryPreviewDataSource,
 	}
 }
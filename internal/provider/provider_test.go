@@ -1,16 +1,24 @@
 package provider
 
 import (
+	"flag"
 	"fmt"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/fake_api"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
 	"os"
 	"testing"
 )
 
+// fakeApiEnvVar runs the acceptance tests against an in-process fake of the ControlMonkey API when set to 1, so the
+// tests can run without a real organization.
+const fakeApiEnvVar = "CM_ACC_FAKE_API"
+
 const (
 	// providerConfig is a shared configuration to combine with the actual test configuration.
 	providerConfig = `
@@ -18,6 +26,72 @@ provider "cm" {}
 `
 )
 
+// testAccFakeApi is the fake API the tests run against, or nil when they run against ControlMonkey.
+var testAccFakeApi *fake_api.Server
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	// Runs the sweepers instead of the tests when -sweep is set, they always run against ControlMonkey.
+	if sweep := flag.Lookup("sweep"); sweep != nil && sweep.Value.String() != "" {
+		resource.TestMain(m)
+		return
+	}
+
+	if os.Getenv(fakeApiEnvVar) == "1" {
+		testAccFakeApi = fake_api.New()
+		_ = os.Setenv(EndpointEnvVar, testAccFakeApi.URL)
+		_ = os.Setenv(credentials.EnvCredentialsVarToken, fake_api.Token)
+		seedFakeApi(testAccFakeApi)
+	}
+
+	code := m.Run()
+
+	if testAccFakeApi != nil {
+		testAccFakeApi.Close()
+	}
+
+	os.Exit(code)
+}
+
+// seedFakeApi creates the entities the tests expect to exist in the organization, and fills in the CM_TEST_*
+// variables that are not set.
+func seedFakeApi(s *fake_api.Server) {
+	c := test_config.Config
+
+	setDefault := func(v *string, value string) {
+		if *v == "" {
+			*v = value
+		}
+	}
+
+	setDefault(&c.ProviderId, "vcs-fake")
+	setDefault(&c.RepoName, "fake/repo")
+	setDefault(&c.CloudAccountId, "123456789012")
+	setDefault(&c.OrgId, fake_api.OrgId)
+	setDefault(&c.SlackWebhookUrl, "https://hooks.slack.com/services/T0/B0/fake")
+	setDefault(&c.NotificationEndpointEmail1, "first@example.com")
+	setDefault(&c.NotificationEndpointEmail2, "second@example.com")
+
+	setDefault(&c.ControlPolicyId, s.Seed("/controlPolicy", map[string]interface{}{"name": "Seeded Control Policy", "type": "aws_denied_regions"}))
+	setDefault(&c.ControlPolicyGroupId, s.Seed("/controlPolicyGroup", map[string]interface{}{"name": "Seeded Control Policy Group"}))
+	setDefault(&c.SlackAppId, s.Seed("/notification/slackApp", map[string]interface{}{"name": "Seeded Slack App"}))
+
+	// Entities the data source tests read by name.
+	namespaceId := s.Seed("/namespace", map[string]interface{}{"name": "Seeded Namespace"})
+	s.Seed("/stack", map[string]interface{}{
+		"name":        "Stack Unique",
+		"iacType":     "terraform",
+		"namespaceId": namespaceId,
+		"data": map[string]interface{}{
+			"deploymentBehavior": map[string]interface{}{"deployOnPush": false, "waitForApproval": false},
+			"vcsInfo":            map[string]interface{}{"providerId": c.ProviderId, "repoName": c.RepoName},
+		},
+	})
+	s.Seed("/template", map[string]interface{}{"name": "Template Unique", "iacType": "terraform"})
+	s.Seed("/blueprint", map[string]interface{}{"name": "Blueprint Unique"})
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv(credentials.EnvCredentialsVarToken); v == "" {
 		t.Fatal(fmt.Printf("%s must be set for acceptance tests", credentials.EnvCredentialsVarToken))
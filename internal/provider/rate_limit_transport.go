@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultBurst = 10
+
+	// maxTooManyRequestsRetries is the number of times a request rejected with 429 Too Many Requests is sent again.
+	maxTooManyRequestsRetries = 3
+	// defaultRetryAfter is the wait before sending a request again, when the API does not set a Retry-After header.
+	defaultRetryAfter = time.Second
+	// maxRetryAfter bounds the wait requested by a Retry-After header.
+	maxRetryAfter = time.Minute
+)
+
+// rateLimitedTransport waits for a token of the shared limiter before sending a request, so all services of a
+// provider instance together stay within the configured rate. Requests rejected with 429 Too Many Requests are sent
+// again after the wait in the Retry-After header of the response.
+type rateLimitedTransport struct {
+	transport http.RoundTripper
+	limiter   *rate.Limiter
+}
+
+// newRequestsLimiter returns the limiter of a provider instance, requestsPerSecond of 0 does not limit the requests.
+func newRequestsLimiter(requestsPerSecond float64, burst int) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+func newRateLimitedTransport(transport http.RoundTripper, limiter *rate.Limiter) http.RoundTripper {
+	return &rateLimitedTransport{transport: transport, limiter: limiter}
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt == maxTooManyRequestsRetries {
+			return resp, err
+		}
+
+		// A request whose body can't be read again is not retried.
+		retryReq := req
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+
+			retryReq = req.Clone(req.Context())
+			retryReq.Body = body
+		}
+
+		wait := retryAfter(resp, time.Now())
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req = retryReq
+	}
+}
+
+//region Private
+
+// retryAfter returns the wait requested by the Retry-After header of the response, in seconds or as an HTTP date.
+func retryAfter(resp *http.Response, now time.Time) time.Duration {
+	retVal := defaultRetryAfter
+
+	if header := resp.Header.Get("Retry-After"); header != "" {
+		if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+			retVal = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(header); err == nil {
+			retVal = date.Sub(now)
+		}
+	}
+
+	if retVal < 0 {
+		retVal = 0
+	}
+	if retVal > maxRetryAfter {
+		retVal = maxRetryAfter
+	}
+
+	return retVal
+}
+
+//endregion
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestNewRequestsLimiter(t *testing.T) {
+	unlimited := newRequestsLimiter(0, defaultBurst)
+	if unlimited.Limit() != rate.Inf {
+		t.Errorf("expected no limit by default, got %v", unlimited.Limit())
+	}
+	for i := 0; i < 100; i++ {
+		if !unlimited.Allow() {
+			t.Fatalf("expected request %d to be allowed without a limit", i)
+		}
+	}
+
+	limited := newRequestsLimiter(1, 2)
+	if !limited.Allow() || !limited.Allow() {
+		t.Errorf("expected the burst to be allowed at once")
+	}
+	if limited.Allow() {
+		t.Errorf("expected a request over the burst to wait")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		header   string
+		expected time.Duration
+	}{
+		"missing":     {header: "", expected: defaultRetryAfter},
+		"seconds":     {header: "3", expected: 3 * time.Second},
+		"http date":   {header: now.Add(5 * time.Second).Format(http.TimeFormat), expected: 5 * time.Second},
+		"date passed": {header: now.Add(-5 * time.Second).Format(http.TimeFormat), expected: 0},
+		"too long":    {header: "3600", expected: maxRetryAfter},
+		"malformed":   {header: "soon", expected: defaultRetryAfter},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if c.header != "" {
+				resp.Header.Set("Retry-After", c.header)
+			}
+
+			if actual := retryAfter(resp, now); actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRateLimitedTransportRetriesTooManyRequests(t *testing.T) {
+	var bodies []string
+	transport := newRateLimitedTransport(testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) < 3 {
+			return testResponse(http.StatusTooManyRequests, "0"), nil
+		}
+		return testResponse(http.StatusOK, ""), nil
+	}), newRequestsLimiter(0, defaultBurst))
+
+	req, _ := http.NewRequest(http.MethodPost, "https://api.example.com/stack", strings.NewReader(`{"name":"stack"}`))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the request to succeed after retries, got %d", resp.StatusCode)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"stack"}` {
+			t.Errorf("expected attempt %d to send the body, got '%s'", i, body)
+		}
+	}
+}
+
+func TestRateLimitedTransportGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	transport := newRateLimitedTransport(testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return testResponse(http.StatusTooManyRequests, "0"), nil
+	}), newRequestsLimiter(0, defaultBurst))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/stack", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected the last 429 to be returned, got %d", resp.StatusCode)
+	}
+	if attempts != maxTooManyRequestsRetries+1 {
+		t.Errorf("expected %d attempts, got %d", maxTooManyRequestsRetries+1, attempts)
+	}
+}
+
+func TestRateLimitedTransportDoesNotRetryBodyThatCantBeReplayed(t *testing.T) {
+	attempts := 0
+	transport := newRateLimitedTransport(testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return testResponse(http.StatusTooManyRequests, "0"), nil
+	}), newRequestsLimiter(0, defaultBurst))
+
+	req, _ := http.NewRequest(http.MethodPost, "https://api.example.com/stack", io.NopCloser(strings.NewReader("{}")))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests || attempts != 1 {
+		t.Errorf("expected a single attempt returning 429, got %d attempts and %d", attempts, resp.StatusCode)
+	}
+}
+
+func TestRateLimitedTransportStopsWaitingWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	transport := newRateLimitedTransport(testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		cancel()
+		return testResponse(http.StatusTooManyRequests, "30"), nil
+	}), newRequestsLimiter(0, defaultBurst))
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/stack", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the wait to be canceled, got %v", err)
+	}
+}
+
+func testResponse(statusCode int, retryAfter string) *http.Response {
+	retVal := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}
+	if retryAfter != "" {
+		retVal.Header.Set("Retry-After", retryAfter)
+	}
+
+	return retVal
+}
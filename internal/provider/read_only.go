@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	readOnlyAttributeName = "read_only"
+	readOnlyEnvVar        = "CONTROL_MONKEY_READ_ONLY"
+)
+
+// readOnlyDiagnostics returns an error when the provider is read only, so a resource can stop before any mutating
+// request is sent to ControlMonkey.
+func (c *ControlMonkeyAPIClient) readOnlyDiagnostics(operation string) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	if c != nil && c.ReadOnly {
+		retVal.AddError(
+			"Provider is read only",
+			fmt.Sprintf("Cannot %s the resource because the provider is configured with %s = true (or %s). Only reads are allowed.", operation, readOnlyAttributeName, readOnlyEnvVar),
+		)
+	}
+
+	return retVal
+}
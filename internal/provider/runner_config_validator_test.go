@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	cm_objectvalidator "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/object"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testRunnerConfigAttributeTypes = map[string]attr.Type{
+	"mode":   types.StringType,
+	"groups": types.ListType{ElemType: types.StringType},
+}
+
+func TestRunnerConfigValidator(t *testing.T) {
+	unknownGroups := types.ListUnknown(types.StringType)
+	nullGroups := types.ListNull(types.StringType)
+
+	cases := map[string]struct {
+		mode          types.String
+		groups        types.List
+		expectedError bool
+	}{
+		"managed without groups":          {mode: types.StringValue(cmTypes.Managed), groups: nullGroups},
+		"managed with groups":             {mode: types.StringValue(cmTypes.Managed), groups: testGroups("group"), expectedError: true},
+		"managed with unknown groups":     {mode: types.StringValue(cmTypes.Managed), groups: unknownGroups, expectedError: true},
+		"self hosted with groups":         {mode: types.StringValue(cmTypes.SelfHosted), groups: testGroups("a", "b")},
+		"self hosted with unknown groups": {mode: types.StringValue(cmTypes.SelfHosted), groups: unknownGroups},
+		"self hosted with empty groups":   {mode: types.StringValue(cmTypes.SelfHosted), groups: testGroups(), expectedError: true},
+		"self hosted with blank group":    {mode: types.StringValue(cmTypes.SelfHosted), groups: testGroups("a", ""), expectedError: true},
+		"self hosted with duplicates":     {mode: types.StringValue(cmTypes.SelfHosted), groups: testGroups("a", "a"), expectedError: true},
+		"unknown mode":                    {mode: types.StringUnknown(), groups: testGroups("a", "a")},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.ObjectRequest{
+				Path: path.Root("runner_config"),
+				ConfigValue: types.ObjectValueMust(testRunnerConfigAttributeTypes, map[string]attr.Value{
+					"mode":   c.mode,
+					"groups": c.groups,
+				}),
+			}
+			var resp validator.ObjectResponse
+
+			cm_objectvalidator.RunnerConfig().ValidateObject(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Fatalf("expected error %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if c.expectedError {
+				if p := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path(); !p.Equal(path.Root("runner_config").AtName("groups")) {
+					t.Errorf("expected the error on runner_config.groups, got %s", p)
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerConfigValidatorSkipsUnconfigured(t *testing.T) {
+	for name, value := range map[string]types.Object{
+		"null":    types.ObjectNull(testRunnerConfigAttributeTypes),
+		"unknown": types.ObjectUnknown(testRunnerConfigAttributeTypes),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var resp validator.ObjectResponse
+			cm_objectvalidator.RunnerConfig().ValidateObject(context.Background(), validator.ObjectRequest{ConfigValue: value}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected errors: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func testGroups(groups ...string) types.List {
+	values := make([]attr.Value, 0, len(groups))
+	for _, g := range groups {
+		values = append(values, types.StringValue(g))
+	}
+
+	return types.ListValueMust(types.StringType, values)
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"gopkg.in/ini.v1"
+)
+
+const (
+	profileEnvVar = "CONTROL_MONKEY_PROFILE"
+
+	sharedCredentialsProviderName = "SharedCredentialsProvider"
+)
+
+// sharedCredentials is a named entry (profile) of the shared credentials file, e.g.
+//
+//	[staging]
+//	token    = ...
+//	endpoint = https://api.staging.example.com
+type sharedCredentials struct {
+	Token    string `ini:"token"`
+	Endpoint string `ini:"endpoint"`
+}
+
+// sharedCredentialsProvider is a credentials.Provider for a profile of the shared credentials file. Unlike
+// credentials.FileProvider, a profile that was selected explicitly must exist, and it may define an API endpoint.
+type sharedCredentialsProvider struct {
+	Filename string
+	Profile  string
+
+	// explicitProfile and explicitFilename are set when the profile or the file were selected by the user rather than
+	// defaulted.
+	explicitProfile  bool
+	explicitFilename bool
+}
+
+// newSharedCredentialsProvider resolves the file and profile from the provider configuration, then the environment,
+// then the SDK defaults.
+func newSharedCredentialsProvider(filename string, profile string) *sharedCredentialsProvider {
+	retVal := &sharedCredentialsProvider{Filename: filename, Profile: profile}
+
+	if retVal.Filename == "" {
+		retVal.Filename = os.Getenv(credentials.FileCredentialsEnvVarFile)
+	}
+	retVal.explicitFilename = retVal.Filename != ""
+	if retVal.Filename == "" {
+		retVal.Filename = credentials.DefaultFilename()
+	}
+
+	for _, p := range []string{retVal.Profile, os.Getenv(profileEnvVar), os.Getenv(credentials.FileCredentialsEnvVarProfile)} {
+		if p != "" {
+			retVal.Profile = p
+			retVal.explicitProfile = true
+			break
+		}
+	}
+	if retVal.Profile == "" {
+		retVal.Profile = credentials.DefaultProfile()
+	}
+
+	return retVal
+}
+
+func (p *sharedCredentialsProvider) Retrieve() (credentials.Value, error) {
+	shared, err := p.load()
+	if err != nil {
+		return credentials.Value{ProviderName: sharedCredentialsProviderName}, err
+	}
+	if shared.Token == "" {
+		return credentials.Value{ProviderName: sharedCredentialsProviderName}, fmt.Errorf("profile %q of shared credentials file %s has no token", p.Profile, p.Filename)
+	}
+
+	return credentials.Value{Token: shared.Token, ProviderName: sharedCredentialsProviderName}, nil
+}
+
+func (p *sharedCredentialsProvider) String() string { return sharedCredentialsProviderName }
+
+// endpoint returns the API endpoint of the profile, or an empty string when the profile does not define one.
+func (p *sharedCredentialsProvider) endpoint() (string, error) {
+	shared, err := p.load()
+	if err != nil {
+		return "", err
+	}
+
+	if shared.Endpoint != "" {
+		if _, err := url.ParseRequestURI(shared.Endpoint); err != nil {
+			return "", fmt.Errorf("invalid endpoint of profile %q in %s: %w", p.Profile, p.Filename, err)
+		}
+	}
+
+	return shared.Endpoint, nil
+}
+
+func (p *sharedCredentialsProvider) load() (*sharedCredentials, error) {
+	file, err := ini.Load(p.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shared credentials file %s: %w", p.Filename, err)
+	}
+
+	retVal := new(sharedCredentials)
+
+	section, err := file.GetSection(p.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("profile %q not found in shared credentials file %s", p.Profile, p.Filename)
+	}
+	if err := section.MapTo(retVal); err != nil {
+		return nil, fmt.Errorf("failed to read profile %q of shared credentials file %s: %w", p.Profile, p.Filename, err)
+	}
+
+	// Like the SDK, a named profile falls back to the default profile for missing values.
+	if p.Profile != credentials.DefaultProfile() {
+		if defaultSection, err := file.GetSection(credentials.DefaultProfile()); err == nil {
+			var defaults sharedCredentials
+			if err := defaultSection.MapTo(&defaults); err == nil {
+				if retVal.Token == "" {
+					retVal.Token = defaults.Token
+				}
+				if retVal.Endpoint == "" {
+					retVal.Endpoint = defaults.Endpoint
+				}
+			}
+		}
+	}
+
+	return retVal, nil
+}
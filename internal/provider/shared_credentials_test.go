@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+)
+
+const testSharedCredentialsFile = `[default]
+token    = default-token
+endpoint = https://api.default.example.com
+
+[staging]
+token = staging-token
+
+[production]
+token    = production-token
+endpoint = https://api.production.example.com
+`
+
+func TestSharedCredentialsProvider(t *testing.T) {
+	t.Setenv(profileEnvVar, "")
+	t.Setenv(credentials.FileCredentialsEnvVarProfile, "")
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filename, []byte(testSharedCredentialsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		profile      string
+		wantToken    string
+		wantEndpoint string
+		wantErr      bool
+	}{
+		{profile: "", wantToken: "default-token", wantEndpoint: "https://api.default.example.com"},
+		{profile: "staging", wantToken: "staging-token", wantEndpoint: "https://api.default.example.com"},
+		{profile: "production", wantToken: "production-token", wantEndpoint: "https://api.production.example.com"},
+		{profile: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		p := newSharedCredentialsProvider(filename, tt.profile)
+
+		v, err := p.Retrieve()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("profile %q: expected an error", tt.profile)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("profile %q: unexpected error: %s", tt.profile, err)
+		}
+
+		endpoint, err := p.endpoint()
+		if err != nil {
+			t.Fatalf("profile %q: unexpected error: %s", tt.profile, err)
+		}
+
+		if v.Token != tt.wantToken {
+			t.Errorf("profile %q: expected token %s, got %s", tt.profile, tt.wantToken, v.Token)
+		}
+		if endpoint != tt.wantEndpoint {
+			t.Errorf("profile %q: expected endpoint %s, got %s", tt.profile, tt.wantEndpoint, endpoint)
+		}
+	}
+}
+
+func TestConfigProfileFromEnvironment(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filename, []byte(testSharedCredentialsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(credentials.FileCredentialsEnvVarFile, filename)
+	t.Setenv(profileEnvVar, "production")
+
+	creds, err := (&Config{}).getCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v, _ := creds.Get(); v.Token != "production-token" {
+		t.Errorf("expected token production-token, got %s", v.Token)
+	}
+
+	creds, err = (&Config{Token: "static-token", Profile: "staging"}).getCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v, _ := creds.Get(); v.Token != "static-token" {
+		t.Errorf("expected the configured token to take precedence over the profile, got %s", v.Token)
+	}
+}
+
+func TestConfigIgnoresDefaultSharedCredentialsFileWhenNotSelected(t *testing.T) {
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, ".controlmonkey"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".controlmonkey", "credentials"), []byte(testSharedCredentialsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("HOME", home)
+	t.Setenv(credentials.FileCredentialsEnvVarFile, "")
+	t.Setenv(profileEnvVar, "")
+	t.Setenv(credentials.FileCredentialsEnvVarProfile, "")
+
+	if _, err := (&Config{}).getCredentials(); err == nil {
+		t.Error("expected the default shared credentials file not to be read without a profile or a file")
+	}
+
+	creds, err := (&Config{Profile: "staging"}).getCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v, _ := creds.Get(); v.Token != "staging-token" {
+		t.Errorf("expected the default shared credentials file to be read for a selected profile, got %s", v.Token)
+	}
+}
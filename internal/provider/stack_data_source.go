@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	tfStack "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack_data"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -73,6 +74,11 @@ func (r *StackDataSource) Configure(_ context.Context, req datasource.ConfigureR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *StackDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Get current state
 	var state tfStack.ResourceModel
 
@@ -87,7 +93,7 @@ func (r *StackDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	res, err := r.client.Client.stack.ListStacks(ctx, stackId, stackName, namespaceId)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read stack"), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read stack"), "", err)...)
 		return
 	} else if len(res) == 0 {
 		resp.Diagnostics.AddError(fmt.Sprintf(resourceNotFoundError), fmt.Sprintf(stackNotFoundError))
@@ -30,7 +30,7 @@ func (r *StackDependencyResource) Metadata(_ context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_stack_dependency"
 }
 
-func (r *StackDependencyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *StackDependencyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys a stack dependency. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/stack/stack-dependencies)",
 		Attributes: map[string]schema.Attribute{
@@ -98,6 +98,9 @@ func (r *StackDependencyResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -123,6 +126,10 @@ func (r *StackDependencyResource) Configure(_ context.Context, req resource.Conf
 }
 
 func (r *StackDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	var state tfStackDependency.ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -130,6 +137,12 @@ func (r *StackDependencyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.stack.ReadDependency(ctx, id)
 	if err != nil {
@@ -137,7 +150,7 @@ func (r *StackDependencyResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read dependency '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read dependency '%s'", id), "", err)...)
 		return
 	}
 	tfStackDependency.UpdateStateAfterRead(res, &state)
@@ -146,6 +159,12 @@ func (r *StackDependencyResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *StackDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan tfStackDependency.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -153,10 +172,16 @@ func (r *StackDependencyResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := tfStackDependency.Converter(&plan, nil, commons.CreateConverter)
 	res, err := r.client.Client.stack.CreateDependency(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(resourceCreationFailedError, fmt.Sprintf("failed to create stack dependency, error: %s", err.Error()))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceCreationFailedError, "failed to create stack dependency", err)...)
 		return
 	}
 
@@ -166,6 +191,12 @@ func (r *StackDependencyResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *StackDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan tfStackDependency.ResourceModel
 	var state tfStackDependency.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -176,11 +207,17 @@ func (r *StackDependencyResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfStackDependency.Converter(&plan, &state, commons.UpdateConverter)
 	_, err := r.client.Client.stack.UpdateDependency(ctx, id, body)
 	if err != nil {
-		resp.Diagnostics.AddError(resourceUpdateFailedError, fmt.Sprintf("failed to update stack dependency %s, error: %s", id, err.Error()))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update stack dependency %s", id), err)...)
 		return
 	}
 
@@ -189,12 +226,24 @@ func (r *StackDependencyResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *StackDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state tfStackDependency.ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	id := state.ID.ValueString()
 	_, err := r.client.Client.stack.DeleteDependency(ctx, id)
 	if err != nil {
@@ -202,7 +251,7 @@ func (r *StackDependencyResource) Delete(ctx context.Context, req resource.Delet
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(resourceDeletionFailedError, fmt.Sprintf("Failed to delete dependency %s, error: %s", id, err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, resourceDeletionFailedError, fmt.Sprintf("Failed to delete dependency %s", id), err)...)
 		return
 	}
 }
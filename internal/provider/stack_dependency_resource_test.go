@@ -15,8 +15,8 @@ const (
 	cmStackDependency = "cm_stack_dependency"
 
 	sdResourceName       = "dep"
-	sdStackName          = "test-stack-dependency-source"
-	sdDependsOnStackName = "test-stack-dependency-target"
+	sdStackName          = test_helpers.NamePrefix + "test-stack-dependency-source"
+	sdDependsOnStackName = test_helpers.NamePrefix + "test-stack-dependency-target"
 	sdTriggerOption      = "always"
 	sdOutputName         = "db_endpoint"
 	sdInputName          = "db_endpoint"
@@ -147,7 +147,7 @@ func stackDependencyResourceName(s string) string {
 func testAccStackDependencyResourceSetup(providerId string, repoName string) string {
 	return providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "test_namespace" {
-  name = "Stack Dependency Test Namespace"
+  name = "`+test_helpers.NamePrefix+`Stack Dependency Test Namespace"
 }
 
 resource "cm_stack" "source" {
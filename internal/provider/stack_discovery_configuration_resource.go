@@ -23,6 +23,7 @@ import (
 )
 
 var _ resource.Resource = &StackDiscoveryConfigurationResource{}
+var _ resource.ResourceWithModifyPlan = &StackDiscoveryConfigurationResource{}
 
 func NewStackDiscoveryConfigurationResource() resource.Resource {
 	return &StackDiscoveryConfigurationResource{}
@@ -36,7 +37,7 @@ func (r *StackDiscoveryConfigurationResource) Metadata(_ context.Context, req re
 	resp.TypeName = req.ProviderTypeName + "_stack_discovery_configuration"
 }
 
-func (r *StackDiscoveryConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *StackDiscoveryConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys stack discovery configurations. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/stack/stack-auto-discovery)",
 		Attributes: map[string]schema.Attribute{
@@ -135,6 +136,9 @@ func (r *StackDiscoveryConfigurationResource) Schema(_ context.Context, _ resour
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -164,35 +168,6 @@ func (r *StackDiscoveryConfigurationResource) ValidateConfig(ctx context.Context
 		return
 	}
 
-	if data.StackConfig != nil && data.StackConfig.RunnerConfig != nil {
-		runnerConfig := data.StackConfig.RunnerConfig
-		mode := runnerConfig.Mode
-
-		if helpers.IsKnown(mode) {
-			modeValue := mode.ValueString()
-
-			if modeValue == cmTypes.Managed && runnerConfig.Groups.IsNull() == false {
-				resp.Diagnostics.AddError(
-					validationError, fmt.Sprintf("stack_config.runner_config.mode with type '%s' cannot have stack_config.runner_config.groups", cmTypes.Managed),
-				)
-			} else if modeValue == cmTypes.SelfHosted && helpers.IsKnown(runnerConfig.Groups) {
-				if len(runnerConfig.Groups.Elements()) == 0 {
-					resp.Diagnostics.AddError(
-						validationError, fmt.Sprintf("stack_config.runner_config.mode with type '%s' requires stack_config.runner_config.groups to be not empty", cmTypes.SelfHosted),
-					)
-				} else if helpers.DoesTfListContainsEmptyValue(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found empty string in stack_config.runner_config.groups",
-					)
-				} else if !helpers.IsTfStringSliceUnique(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found duplicate in stack_config.runner_config.groups",
-					)
-				}
-			}
-		}
-	}
-
 	// Validate path_patterns elements are not blank
 	if data.VcsPatterns != nil {
 		for i, vcsPattern := range data.VcsPatterns {
@@ -212,9 +187,39 @@ func (r *StackDiscoveryConfigurationResource) ValidateConfig(ctx context.Context
 			}
 		}
 	}
+
+	if data.StackConfig != nil && data.StackConfig.IacConfig != nil {
+		iacConfigPath := path.Root("stack_config").AtName("iac_config")
+		versions := iacVersions{
+			TerraformVersion:  data.StackConfig.IacConfig.TerraformVersion,
+			TerragruntVersion: data.StackConfig.IacConfig.TerragruntVersion,
+			OpentofuVersion:   data.StackConfig.IacConfig.OpentofuVersion,
+		}
+
+		resp.Diagnostics.Append(validateIacVersionsMatchIacType(iacConfigPath, data.StackConfig.IacType, versions)...)
+		resp.Diagnostics.Append(r.client.iacVersionsBelowMinimumWarnings(iacConfigPath, versions)...)
+	}
+}
+
+// ModifyPlan fails when the stack discovery configuration is planned in a namespace that is not allowed by the provider configuration.
+func (r *StackDiscoveryConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.client.hasNamespaceRestrictions() {
+		return
+	}
+
+	var plan tfStackDiscoveryConfiguration.ResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), plan.NamespaceId)...)
 }
 
 func (r *StackDiscoveryConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	var state tfStackDiscoveryConfiguration.ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -222,6 +227,12 @@ func (r *StackDiscoveryConfigurationResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.stackDiscoveryConfiguration.ReadStackDiscoveryConfiguration(ctx, id)
 	if err != nil {
@@ -230,7 +241,7 @@ func (r *StackDiscoveryConfigurationResource) Read(ctx context.Context, req reso
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read stack discovery configuration %s", id), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read stack discovery configuration %s", id), "", err)...)
 		return
 	}
 
@@ -244,6 +255,12 @@ func (r *StackDiscoveryConfigurationResource) Read(ctx context.Context, req reso
 }
 
 func (r *StackDiscoveryConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan tfStackDiscoveryConfiguration.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -251,14 +268,17 @@ func (r *StackDiscoveryConfigurationResource) Create(ctx context.Context, req re
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := tfStackDiscoveryConfiguration.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.stackDiscoveryConfiguration.CreateStackDiscoveryConfiguration(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Stack discovery configuration creation failed",
-			fmt.Sprintf("failed to create stack discovery configuration, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, "Stack discovery configuration creation failed", "failed to create stack discovery configuration", err)...)
 		return
 	}
 
@@ -272,6 +292,12 @@ func (r *StackDiscoveryConfigurationResource) Create(ctx context.Context, req re
 }
 
 func (r *StackDiscoveryConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan tfStackDiscoveryConfiguration.ResourceModel
 	var state tfStackDiscoveryConfiguration.ResourceModel
 
@@ -284,6 +310,12 @@ func (r *StackDiscoveryConfigurationResource) Update(ctx context.Context, req re
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := tfStackDiscoveryConfiguration.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -294,10 +326,7 @@ func (r *StackDiscoveryConfigurationResource) Update(ctx context.Context, req re
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update stack discovery configuration %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update stack discovery configuration %s", id), err)...)
 		return
 	}
 
@@ -309,6 +338,12 @@ func (r *StackDiscoveryConfigurationResource) Update(ctx context.Context, req re
 }
 
 func (r *StackDiscoveryConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state tfStackDiscoveryConfiguration.ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -316,6 +351,12 @@ func (r *StackDiscoveryConfigurationResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
 	_, err := r.client.Client.stackDiscoveryConfiguration.DeleteStackDiscoveryConfiguration(ctx, id)
@@ -326,10 +367,7 @@ func (r *StackDiscoveryConfigurationResource) Delete(ctx context.Context, req re
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Stack discovery configuration deletion failed",
-			fmt.Sprintf("Failed to delete stack discovery configuration %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Stack discovery configuration deletion failed", fmt.Sprintf("Failed to delete stack discovery configuration %s", id), err)...)
 		return
 	}
 }
@@ -14,7 +14,7 @@ const (
 	cmStackDiscoveryConfiguration = "cm_stack_discovery_configuration"
 
 	sdcResourceName     = "test"
-	sdcName             = "Test Stack Discovery Configuration"
+	sdcName             = test_helpers.NamePrefix + "Test Stack Discovery Configuration"
 	sdcDescription      = "Test configuration for stack auto-discovery"
 	sdcBranch           = "main"
 	sdcIacType          = "terraform"
@@ -208,7 +208,7 @@ func stackDiscoveryConfigurationResourceName(s string) string {
 func testAccStackDiscoveryConfigurationResourceSetup() string {
 	return providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "test_namespace" {
-  name = "Stack Conviguration Namespace"
+  name = "`+test_helpers.NamePrefix+`Stack Conviguration Namespace"
 }
 `)
 }
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
+	tfStackDiscoveryPreview "github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack_discovery_preview_data"
+	cmStringValidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	discoveredRootStackName = "root"
+	terragruntConfigFile    = "terragrunt.hcl"
+)
+
+// iacFileSuffixes are the suffixes of the files that make a directory a stack.
+var iacFileSuffixes = []string{".tf", ".tf.json", ".tofu", ".tofu.json"}
+
+// skippedDiscoveryDirectories are not part of the repository, Terraform and Terragrunt create them in a local checkout.
+var skippedDiscoveryDirectories = []string{".git", ".terraform", ".terragrunt-cache"}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StackDiscoveryPreviewDataSource{}
+
+func NewStackDiscoveryPreviewDataSource() datasource.DataSource {
+	return &StackDiscoveryPreviewDataSource{}
+}
+
+// StackDiscoveryPreviewDataSource matches the patterns locally and does not call the API.
+type StackDiscoveryPreviewDataSource struct{}
+
+func (r *StackDiscoveryPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_discovery_preview"
+}
+
+func (r *StackDiscoveryPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Previews the stacks that the `vcs_patterns` of a `cm_stack_discovery_configuration` discover in a repository, " +
+			"without calling ControlMonkey. A directory of the repository is a stack when it holds a Terraform or OpenTofu file " +
+			"(`.tf`, `.tf.json`, `.tofu`, `.tofu.json`) or a `terragrunt.hcl`, its path matches one of the `path_patterns` and none of " +
+			"the `exclude_path_patterns`. Patterns are matched against the path of the directory relative to the root of the " +
+			"repository: `*` matches any characters of a single directory name, `?` a single character, `[...]` a character class " +
+			"and `**` any number of directories, including none. The matching approximates the one of ControlMonkey, which is not " +
+			"published, and may differ in edge cases: leading and trailing `/` are ignored, a trailing `/**` also matches the " +
+			"directory itself, and `*` and `**` match names that start with a `.` like any other name.",
+		Attributes: map[string]schema.Attribute{
+			"path_patterns": schema.ListAttribute{
+				MarkdownDescription: "List of path patterns to include for stack discovery.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators:          commons.ValidateUniqueNotEmptyListWithNoBlankValues(),
+			},
+			"exclude_path_patterns": schema.ListAttribute{
+				MarkdownDescription: "List of path patterns to exclude from stack discovery.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          commons.ValidateUniqueNotEmptyListWithNoBlankValues(),
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "The paths of the files of the repository relative to its root, e.g. the output of `git ls-files` or `fileset()`. Exactly one of `paths` or `directory` is required.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(tfPath.MatchRoot("directory")),
+				},
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "A local checkout of the repository to walk. The `.git`, `.terraform` and `.terragrunt-cache` directories are skipped.",
+				Optional:            true,
+				Validators: []validator.String{
+					cmStringValidators.NotBlank(),
+				},
+			},
+			"stacks": schema.ListNestedAttribute{
+				MarkdownDescription: "The stacks that would be discovered, ordered by path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The name of the stack, its path with `/` replaced by `-`, or `%s` for the root of the repository.", discoveredRootStackName),
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path of the stack relative to the root of the repository, empty for the root.",
+							Computed:            true,
+						},
+						"matched_pattern": schema.StringAttribute{
+							MarkdownDescription: "The first of the `path_patterns` that matches the path.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StackDiscoveryPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tfStackDiscoveryPreview.ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathPatterns := discoveryPatterns(ctx, state.PathPatterns, tfPath.Root("path_patterns"), &resp.Diagnostics)
+	excludePathPatterns := discoveryPatterns(ctx, state.ExcludePathPatterns, tfPath.Root("exclude_path_patterns"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var files []string
+	if state.Directory.IsNull() {
+		resp.Diagnostics.Append(state.Paths.ElementsAs(ctx, &files, false)...)
+	} else {
+		var err error
+		if files, err = repositoryFiles(state.Directory.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(tfPath.Root("directory"), validationError, fmt.Sprintf("Failed to walk directory: %s", err))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Stacks = discoverStacks(files, pathPatterns, excludePathPatterns)
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+//region Private Methods
+
+// discoveryPatterns returns the patterns of the list, a malformed pattern is an error of its element.
+func discoveryPatterns(ctx context.Context, list types.List, attributePath tfPath.Path, diagnostics *diag.Diagnostics) []string {
+	var retVal []string
+	if list.IsNull() {
+		return retVal
+	}
+
+	diagnostics.Append(list.ElementsAs(ctx, &retVal, false)...)
+
+	for i, pattern := range retVal {
+		for _, segment := range splitDiscoveryPath(pattern) {
+			if _, err := path.Match(segment, ""); err != nil {
+				diagnostics.AddAttributeError(attributePath.AtListIndex(i), validationError, fmt.Sprintf("Pattern '%s' is malformed", pattern))
+				break
+			}
+		}
+	}
+
+	return retVal
+}
+
+// repositoryFiles returns the paths of the files under the directory, relative to it.
+func repositoryFiles(directory string) ([]string, error) {
+	var retVal []string
+
+	err := filepath.WalkDir(directory, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			for _, skipped := range skippedDiscoveryDirectories {
+				if d.Name() == skipped {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(directory, p)
+		if err != nil {
+			return err
+		}
+		retVal = append(retVal, filepath.ToSlash(rel))
+
+		return nil
+	})
+
+	return retVal, err
+}
+
+// discoverStacks returns a stack for every directory of the files that holds IaC files and is matched by the patterns.
+func discoverStacks(files []string, pathPatterns []string, excludePathPatterns []string) []*tfStackDiscoveryPreview.DiscoveredStackModel {
+	directories := make(map[string]bool)
+	for _, f := range files {
+		f = strings.TrimPrefix(path.Clean("/"+f), "/")
+		if isIacFile(path.Base(f)) {
+			directories[strings.TrimPrefix(path.Dir("/"+f), "/")] = true
+		}
+	}
+
+	retVal := make([]*tfStackDiscoveryPreview.DiscoveredStackModel, 0)
+
+	for _, directory := range helpers.SortedKeys(directories) {
+		matchedPattern := ""
+		for _, pattern := range pathPatterns {
+			if matchDiscoveryPattern(pattern, directory) {
+				matchedPattern = pattern
+				break
+			}
+		}
+		if matchedPattern == "" {
+			continue
+		}
+
+		excluded := false
+		for _, pattern := range excludePathPatterns {
+			if matchDiscoveryPattern(pattern, directory) {
+				excluded = true
+				break
+			}
+		}
+		if excluded {
+			continue
+		}
+
+		retVal = append(retVal, &tfStackDiscoveryPreview.DiscoveredStackModel{
+			Name:           types.StringValue(discoveredStackName(directory)),
+			Path:           types.StringValue(directory),
+			MatchedPattern: types.StringValue(matchedPattern),
+		})
+	}
+
+	return retVal
+}
+
+func isIacFile(name string) bool {
+	if name == terragruntConfigFile {
+		return true
+	}
+
+	for _, suffix := range iacFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func discoveredStackName(directory string) string {
+	if directory == "" {
+		return discoveredRootStackName
+	}
+
+	return strings.ReplaceAll(directory, "/", "-")
+}
+
+// matchDiscoveryPattern approximates how ControlMonkey matches a pattern of a stack discovery configuration to a
+// directory, the preview may differ from the discovery in edge cases. Leading and trailing slashes are ignored, a
+// trailing ** also matches the directory itself and hidden names are matched like any other name.
+func matchDiscoveryPattern(pattern string, directory string) bool {
+	return matchDiscoveryPathSegments(splitDiscoveryPath(pattern), splitDiscoveryPath(directory))
+}
+
+func matchDiscoveryPathSegments(pattern []string, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// ** matches any number of directories, including none.
+			for i := 0; i <= len(segments); i++ {
+				if matchDiscoveryPathSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+			return false
+		}
+
+		pattern, segments = pattern[1:], segments[1:]
+	}
+
+	return len(segments) == 0
+}
+
+func splitDiscoveryPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+
+	return strings.Split(p, "/")
+}
+
+//endregion
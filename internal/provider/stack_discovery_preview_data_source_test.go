@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStackDiscoveryPreviewDataSource(t *testing.T) {
+	directory := t.TempDir()
+	for _, f := range []string{"main.tf", "environments/prod/terraform/main.tf", "environments/prod/terraform/.terraform/modules/vpc/main.tf", "modules/vpc/test/main.tf", "README.md"} {
+		p := filepath.Join(directory, filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "cm_stack_discovery_preview" "preview" {
+  path_patterns = ["environments/[a-"]
+  paths         = ["environments/prod/main.tf"]
+}`,
+				ExpectError: regexp.MustCompile("Pattern 'environments/\\[a-' is malformed"),
+			},
+			{
+				Config: providerConfig + `
+data "cm_stack_discovery_preview" "preview" {
+  path_patterns = ["**"]
+}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+data "cm_stack_discovery_preview" "preview" {
+  path_patterns         = ["environments/*/terraform/**", "modules/*/**"]
+  exclude_path_patterns = ["**/test/**"]
+  paths = [
+    "environments/prod/terraform/main.tf",
+    "environments/prod/terraform/network/vpc.tf",
+    "environments/dev/terraform/terragrunt.hcl",
+    "environments/dev/docs/README.md",
+    "modules/vpc/main.tf",
+    "modules/vpc/test/main.tf",
+    "scripts/run.sh",
+  ]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.#", "4"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.0.path", "environments/dev/terraform"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.0.name", "environments-dev-terraform"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.0.matched_pattern", "environments/*/terraform/**"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.1.path", "environments/prod/terraform"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.2.path", "environments/prod/terraform/network"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.3.path", "modules/vpc"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.3.matched_pattern", "modules/*/**"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "cm_stack_discovery_preview" "preview" {
+  path_patterns         = ["**"]
+  exclude_path_patterns = ["modules/**"]
+  directory             = %q
+}`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.#", "2"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.0.path", ""),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.0.name", "root"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.0.matched_pattern", "**"),
+					resource.TestCheckResourceAttr("data.cm_stack_discovery_preview.preview", "stacks.1.path", "environments/prod/terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestMatchDiscoveryPattern(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		directory string
+		expected  bool
+	}{
+		{"environments/*/terraform/**", "environments/prod/terraform", true},
+		{"environments/*/terraform/**", "environments/prod/terraform/network", true},
+		{"environments/*/terraform/**", "environments/prod/eu/terraform", false},
+		{"environments/*/terraform", "environments/prod/terraform/network", false},
+		{"**/infrastructure/**", "infrastructure", true},
+		{"**/infrastructure/**", "services/api/infrastructure/aws", true},
+		{"**/infrastructure/**", "services/api/infrastructure-old", false},
+		{"/live/*", "live/prod", true},
+		{"/live/**", "live", true},
+		{"live/*", "/live/prod", true},
+		{"live/*/", "live/prod", true},
+		{"live/**", "live", true},
+		{"live/**", "live-old/prod", false},
+		{"*", ".github", true},
+		{"**", ".hidden/stack", true},
+		{"live/*", "live/.old", true},
+		{".*/**", ".github/workflows", true},
+		{".*/**", "github/workflows", false},
+		{"live/prod-?", "live/prod-1", true},
+		{"live/[a-c]*", "live/dev", false},
+		{"**", "", true},
+		{"*", "", false},
+	}
+
+	for _, test := range tests {
+		if actual := matchDiscoveryPattern(test.pattern, test.directory); actual != test.expected {
+			t.Errorf("matchDiscoveryPattern(%q, %q) = %v, expected %v", test.pattern, test.directory, actual, test.expected)
+		}
+	}
+}
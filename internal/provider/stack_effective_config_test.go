@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkCrossModels "github.com/control-monkey/controlmonkey-sdk-go/services/cross_models"
+	sdkNamespace "github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	sdkOrganization "github.com/control-monkey/controlmonkey-sdk-go/services/organization"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/cross_models"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestEffectiveIacConfig(t *testing.T) {
+	stackIacConfig := &cross_models.IacConfigModel{TerraformVersion: types.StringValue("1.5.0"), TerragruntVersion: types.StringNull(), OpentofuVersion: types.StringNull()}
+	namespace := &sdkNamespace.Namespace{IacConfig: &sdkNamespace.IacConfig{TerraformVersion: controlmonkey.String("1.6.0")}}
+	org := &sdkOrganization.OrgConfiguration{IacConfig: &sdkOrganization.IacConfig{OpentofuVersion: controlmonkey.String("1.7.0")}}
+
+	cases := map[string]struct {
+		stack             *cross_models.IacConfigModel
+		namespace         *sdkNamespace.Namespace
+		org               *sdkOrganization.OrgConfiguration
+		expectedSource    string
+		expectedTerraform string
+	}{
+		"stack wins":            {stack: stackIacConfig, namespace: namespace, org: org, expectedSource: stack.EffectiveSourceStack, expectedTerraform: "1.5.0"},
+		"namespace over org":    {namespace: namespace, org: org, expectedSource: stack.EffectiveSourceNamespace, expectedTerraform: "1.6.0"},
+		"org":                   {org: org, expectedSource: stack.EffectiveSourceOrg},
+		"not configured at all": {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := testEffectiveConfigState()
+			state.IacConfig = c.stack
+
+			if diags := stack.UpdateEffectiveConfig(context.Background(), c.namespace, c.org, state); diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			effective := testEffectiveObject[stack.EffectiveIacConfigModel](t, state.EffectiveIacConfig)
+			if c.expectedSource == "" {
+				if effective != nil {
+					t.Errorf("expected no effective IaC config, got %+v", effective)
+				}
+				return
+			}
+
+			if effective.Source.ValueString() != c.expectedSource || effective.TerraformVersion.ValueString() != c.expectedTerraform {
+				t.Errorf("expected terraform '%s' from %s, got '%s' from %s", c.expectedTerraform, c.expectedSource, effective.TerraformVersion.ValueString(), effective.Source.ValueString())
+			}
+		})
+	}
+}
+
+func TestEffectiveRunnerConfig(t *testing.T) {
+	stackRunnerConfig := &cross_models.RunnerConfigModel{Mode: types.StringValue(cmTypes.SelfHosted), Groups: testGroups("stack-group")}
+	namespaceRunnerConfig := func(isOverridable bool) *sdkNamespace.Namespace {
+		return &sdkNamespace.Namespace{RunnerConfig: &sdkNamespace.RunnerConfig{
+			Mode: controlmonkey.String(cmTypes.SelfHosted), Groups: []*string{controlmonkey.String("namespace-group")}, IsOverridable: controlmonkey.Bool(isOverridable),
+		}}
+	}
+	orgRunnerConfig := func(isOverridable bool) *sdkOrganization.OrgConfiguration {
+		return &sdkOrganization.OrgConfiguration{RunnerConfig: &sdkOrganization.RunnerConfig{
+			Mode: controlmonkey.String(cmTypes.Managed), IsOverridable: controlmonkey.Bool(isOverridable),
+		}}
+	}
+
+	cases := map[string]struct {
+		stack          *cross_models.RunnerConfigModel
+		namespace      *sdkNamespace.Namespace
+		org            *sdkOrganization.OrgConfiguration
+		expectedSource string
+		expectedMode   string
+	}{
+		"org not overridable wins":       {stack: stackRunnerConfig, namespace: namespaceRunnerConfig(false), org: orgRunnerConfig(false), expectedSource: stack.EffectiveSourceOrg, expectedMode: cmTypes.Managed},
+		"namespace not overridable wins": {stack: stackRunnerConfig, namespace: namespaceRunnerConfig(false), org: orgRunnerConfig(true), expectedSource: stack.EffectiveSourceNamespace, expectedMode: cmTypes.SelfHosted},
+		"stack overrides":                {stack: stackRunnerConfig, namespace: namespaceRunnerConfig(true), org: orgRunnerConfig(true), expectedSource: stack.EffectiveSourceStack, expectedMode: cmTypes.SelfHosted},
+		"namespace default":              {namespace: namespaceRunnerConfig(true), org: orgRunnerConfig(true), expectedSource: stack.EffectiveSourceNamespace, expectedMode: cmTypes.SelfHosted},
+		"org default":                    {org: orgRunnerConfig(true), expectedSource: stack.EffectiveSourceOrg, expectedMode: cmTypes.Managed},
+		"not configured at all":          {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := testEffectiveConfigState()
+			state.RunnerConfig = c.stack
+
+			if diags := stack.UpdateEffectiveConfig(context.Background(), c.namespace, c.org, state); diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			effective := testEffectiveObject[stack.EffectiveRunnerConfigModel](t, state.EffectiveRunnerConfig)
+			if c.expectedSource == "" {
+				if effective != nil {
+					t.Errorf("expected no effective runner config, got %+v", effective)
+				}
+				return
+			}
+
+			if effective.Source.ValueString() != c.expectedSource || effective.Mode.ValueString() != c.expectedMode {
+				t.Errorf("expected mode '%s' from %s, got '%s' from %s", c.expectedMode, c.expectedSource, effective.Mode.ValueString(), effective.Source.ValueString())
+			}
+		})
+	}
+}
+
+func TestEffectiveDeploymentApprovalPolicy(t *testing.T) {
+	stackPolicy := &cross_models.DeploymentApprovalPolicyModel{Rules: []*cross_models.DeploymentApprovalPolicyRuleModel{
+		{Type: types.StringValue("requireTwoApprovals"), Parameters: jsontypes.NewNormalizedNull()},
+	}}
+	namespacePolicy := func(overrideBehavior string) *sdkNamespace.Namespace {
+		return &sdkNamespace.Namespace{DeploymentApprovalPolicy: &sdkNamespace.DeploymentApprovalPolicy{
+			Rules:            []*sdkCrossModels.DeploymentApprovalPolicyRule{{Type: controlmonkey.String("requireTeamsApproval")}},
+			OverrideBehavior: controlmonkey.String(overrideBehavior),
+		}}
+	}
+
+	cases := map[string]struct {
+		stack           *cross_models.DeploymentApprovalPolicyModel
+		namespace       *sdkNamespace.Namespace
+		expectedSources []string
+	}{
+		"deny keeps the namespace rules":     {stack: stackPolicy, namespace: namespacePolicy(cmTypes.Deny), expectedSources: []string{stack.EffectiveSourceNamespace}},
+		"extended adds the stack rules":      {stack: stackPolicy, namespace: namespacePolicy(cmTypes.Extended), expectedSources: []string{stack.EffectiveSourceNamespace, stack.EffectiveSourceStack}},
+		"allow replaces the namespace rules": {stack: stackPolicy, namespace: namespacePolicy(cmTypes.Allow), expectedSources: []string{stack.EffectiveSourceStack}},
+		"namespace rules without a policy":   {namespace: namespacePolicy(cmTypes.Allow), expectedSources: []string{stack.EffectiveSourceNamespace}},
+		"stack rules without a namespace":    {stack: stackPolicy, expectedSources: []string{stack.EffectiveSourceStack}},
+		"no rules":                           {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := testEffectiveConfigState()
+			state.DeploymentApprovalPolicy = c.stack
+
+			if diags := stack.UpdateEffectiveConfig(context.Background(), c.namespace, nil, state); diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			effective := testEffectiveObject[stack.EffectiveDeploymentApprovalPolicyModel](t, state.EffectiveDeploymentApprovalPolicy)
+			if len(c.expectedSources) == 0 {
+				if effective != nil {
+					t.Errorf("expected no effective deployment approval policy, got %+v", effective)
+				}
+				return
+			}
+
+			var sources []string
+			for _, rule := range effective.Rules {
+				sources = append(sources, rule.Source.ValueString())
+			}
+			if len(sources) != len(c.expectedSources) {
+				t.Fatalf("expected rules from %v, got %v", c.expectedSources, sources)
+			}
+			for i := range sources {
+				if sources[i] != c.expectedSources[i] {
+					t.Errorf("expected rules from %v, got %v", c.expectedSources, sources)
+				}
+			}
+		})
+	}
+}
+
+func TestEffectiveCapabilities(t *testing.T) {
+	namespace := &sdkNamespace.Namespace{Capabilities: &sdkNamespace.Capabilities{
+		DeployOnPush: &sdkNamespace.CapabilityConfig{Status: controlmonkey.String("disabled"), IsOverridable: controlmonkey.Bool(false)},
+		PlanOnPr:     &sdkNamespace.CapabilityConfig{Status: controlmonkey.String("disabled"), IsOverridable: controlmonkey.Bool(true)},
+	}}
+	capabilities := &stack.CapabilitiesModel{
+		DeployOnPush: &stack.CapabilityConfigModel{Status: types.StringValue("enabled")},
+		PlanOnPr:     &stack.CapabilityConfigModel{Status: types.StringValue("enabled")},
+	}
+
+	state := testEffectiveConfigState()
+	state.Capabilities = capabilities
+
+	if diags := stack.UpdateEffectiveConfig(context.Background(), namespace, nil, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	effective := testEffectiveObject[stack.EffectiveCapabilitiesModel](t, state.EffectiveCapabilities)
+	if effective == nil {
+		t.Fatalf("expected effective capabilities")
+	}
+
+	if s := effective.DeployOnPush; s.Status.ValueString() != "disabled" || s.Source.ValueString() != stack.EffectiveSourceNamespace {
+		t.Errorf("expected deploy_on_push of the namespace that can't be overridden, got %+v", s)
+	}
+	if s := effective.PlanOnPr; s.Status.ValueString() != "enabled" || s.Source.ValueString() != stack.EffectiveSourceStack {
+		t.Errorf("expected plan_on_pr of the stack, got %+v", s)
+	}
+	if effective.DriftDetection != nil {
+		t.Errorf("expected no drift_detection, got %+v", effective.DriftDetection)
+	}
+
+	state = testEffectiveConfigState()
+	if diags := stack.UpdateEffectiveConfig(context.Background(), nil, nil, state); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if !state.EffectiveCapabilities.IsNull() {
+		t.Errorf("expected no effective capabilities, got %s", state.EffectiveCapabilities)
+	}
+}
+
+func testEffectiveConfigState() *stack.ResourceModel {
+	state := &stack.ResourceModel{}
+	stack.NullEffectiveConfig(state)
+
+	return state
+}
+
+func testEffectiveObject[T any](t *testing.T, object types.Object) *T {
+	t.Helper()
+
+	if object.IsNull() {
+		return nil
+	}
+
+	retVal := new(T)
+	if diags := object.As(context.Background(), retVal, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("failed to read %s: %v", object, diags)
+	}
+
+	return retVal
+}
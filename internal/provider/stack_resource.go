@@ -11,7 +11,9 @@ import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/cross_schema"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/stack"
 	cm_stringvalidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,10 +21,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &StackResource{}
+var _ resource.ResourceWithModifyPlan = &StackResource{}
 
 func NewStackResource() resource.Resource {
 	return &StackResource{}
@@ -36,9 +40,9 @@ func (r *StackResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_stack"
 }
 
-func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *StackResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates, updates and destroys stacks. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/stack)",
+		MarkdownDescription: "Creates, updates and destroys stacks. The `effective_*` attributes resolve the settings of the stack against its namespace and the org configuration, settings of templates are not taken into account. They are null when the namespace or the org configuration can't be read, both are read once per run for all the stacks. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/stack)",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique ID of the stack.",
@@ -150,6 +154,90 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			deletionProtectionAttributeName: deletionProtectionAttribute("stack"),
+			"effective_iac_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "The IaC configuration the stack actually uses, resolved from the stack, its namespace and the org configuration.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"terraform_version": schema.StringAttribute{
+						MarkdownDescription: "The effective Terraform version.",
+						Computed:            true,
+					},
+					"terragrunt_version": schema.StringAttribute{
+						MarkdownDescription: "The effective Terragrunt version.",
+						Computed:            true,
+					},
+					"opentofu_version": schema.StringAttribute{
+						MarkdownDescription: "The effective OpenTofu version.",
+						Computed:            true,
+					},
+					"source": effectiveSourceSchema(),
+				},
+			},
+			"effective_runner_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "The runner configuration the stack actually uses, resolved from the stack, its namespace and the org configuration while respecting `is_overridable`.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "The effective runner mode.",
+						Computed:            true,
+					},
+					"groups": schema.ListAttribute{
+						MarkdownDescription: "The effective runner groups.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"source": effectiveSourceSchema(),
+				},
+			},
+			"effective_deployment_approval_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "The deployment approval policy the stack actually uses, resolved from the stack and its namespace according to the namespace `override_behavior`.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"rules": schema.ListNestedAttribute{
+						MarkdownDescription: "The effective rules.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the rule.",
+									Computed:            true,
+								},
+								"parameters": schema.StringAttribute{
+									MarkdownDescription: "JSON format of the rule parameters.",
+									Computed:            true,
+									CustomType:          jsontypes.NormalizedType{},
+								},
+								"source": effectiveSourceSchema(),
+							},
+						},
+					},
+				},
+			},
+			"effective_capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities the stack actually uses, resolved from the stack and its namespace while respecting `is_overridable`.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"deploy_on_push": schema.SingleNestedAttribute{
+						MarkdownDescription: "The effective deploy on push capability.",
+						Computed:            true,
+						Attributes:          effectiveCapabilityConfigSchema(),
+					},
+					"plan_on_pr": schema.SingleNestedAttribute{
+						MarkdownDescription: "The effective plan on pull request capability.",
+						Computed:            true,
+						Attributes:          effectiveCapabilityConfigSchema(),
+					},
+					"drift_detection": schema.SingleNestedAttribute{
+						MarkdownDescription: "The effective drift detection capability.",
+						Computed:            true,
+						Attributes:          effectiveCapabilityConfigSchema(),
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -166,6 +254,23 @@ func stackCapabilityConfigSchema() map[string]schema.Attribute {
 	}
 }
 
+func effectiveCapabilityConfigSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Whether the capability is enabled or disabled.",
+			Computed:            true,
+		},
+		"source": effectiveSourceSchema(),
+	}
+}
+
+func effectiveSourceSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The level the value was taken from. Possible values: %s.", helpers.EnumForDocs(stack.EffectiveSourceTypes)),
+		Computed:            true,
+	}
+}
+
 // Configure adds the provider configured client to the data source.
 func (r *StackResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -194,39 +299,87 @@ func (r *StackResource) ValidateConfig(ctx context.Context, req resource.Validat
 		return
 	}
 
-	runnerConfig := data.RunnerConfig
-
-	if runnerConfig != nil {
-		mode := runnerConfig.Mode
-
-		if helpers.IsKnown(mode) {
-			modeValue := mode.ValueString()
-
-			if modeValue == cmTypes.Managed && runnerConfig.Groups.IsNull() == false {
-				resp.Diagnostics.AddError(
-					validationError, fmt.Sprintf("runner_config.mode with type '%s' cannot have runner_config.groups", cmTypes.Managed),
-				)
-			} else if modeValue == cmTypes.SelfHosted && helpers.IsKnown(runnerConfig.Groups) {
-				if len(runnerConfig.Groups.Elements()) == 0 {
-					resp.Diagnostics.AddError(
-						validationError, fmt.Sprintf("runner_config.mode with type '%s' requires runner_config.groups to be not empty", cmTypes.SelfHosted),
-					)
-				} else if helpers.DoesTfListContainsEmptyValue(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found empty string in runner_config.groups",
-					)
-				} else if !helpers.IsTfStringSliceUnique(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found duplicate in runner_config.groups",
-					)
-				}
-			}
+	if data.IacConfig != nil {
+		versions := iacVersions{
+			TerraformVersion:  data.IacConfig.TerraformVersion,
+			TerragruntVersion: data.IacConfig.TerragruntVersion,
+			OpentofuVersion:   data.IacConfig.OpentofuVersion,
+		}
+
+		resp.Diagnostics.Append(validateIacVersionsMatchIacType(path.Root("iac_config"), data.IacType, versions)...)
+		resp.Diagnostics.Append(r.client.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions)...)
+	}
+}
+
+// ModifyPlan fails when a stack with deletion protection would be destroyed or replaced, or when the stack is planned
+// in a namespace that is not allowed by the provider configuration. It also warns when the stack overrides the
+// runner_config of a namespace that does not allow it. The namespace is only read when the runner_config or the
+// namespace of the stack changes, and failing to read it doesn't fail the plan.
+func (r *StackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(deletionProtectionPlanDiagnostics(ctx, "stack", req, path.Root("namespace_id"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() || !r.client.isConfigured() {
+		return
+	}
+
+	var plan stack.ResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespace_id"), plan.NamespaceId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RunnerConfig == nil || !helpers.IsKnown(plan.NamespaceId) {
+		return
+	}
+
+	// The namespace was already checked when the runner_config or the namespace of the stack last changed.
+	if !req.State.Raw.IsNull() {
+		var stateNamespaceId types.String
+		var stateRunnerConfig, planRunnerConfig types.Object
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("namespace_id"), &stateNamespaceId)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("runner_config"), &stateRunnerConfig)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("runner_config"), &planRunnerConfig)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+
+		if stateNamespaceId.Equal(plan.NamespaceId) && stateRunnerConfig.Equal(planRunnerConfig) {
+			return
+		}
+	}
+
+	namespaceId := plan.NamespaceId.ValueString()
+	res, err := r.client.Client.namespace.ReadNamespace(ctx, namespaceId)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to read namespace to check runner_config override", map[string]interface{}{
+			"namespace_id": namespaceId,
+			"error":        err.Error(),
+		})
+		return
+	}
+
+	if res.RunnerConfig != nil && res.RunnerConfig.IsOverridable != nil && *res.RunnerConfig.IsOverridable == false {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("runner_config"),
+			"Namespace runner_config is not overridable",
+			fmt.Sprintf("The runner_config of namespace %s is configured with is_overridable = false, so stacks in this namespace cannot override it.", namespaceId),
+		)
 	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state stack.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -235,6 +388,12 @@ func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.stack.ReadStack(ctx, id)
 	if err != nil {
@@ -243,12 +402,17 @@ func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read stack %s", id), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read stack %s", id), "", err)...)
 		return
 	}
 
 	stack.UpdateStateAfterRead(res, &state)
 
+	resp.Diagnostics.Append(r.updateEffectiveConfig(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -259,6 +423,12 @@ func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan stack.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -267,19 +437,25 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := stack.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.stack.CreateStack(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Stack creation failed",
-			fmt.Sprintf("failed to create stack, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, "Stack creation failed", "failed to create stack", err)...)
 		return
 	}
 
 	plan.ID = types.StringValue(controlmonkey.StringValue(res.ID))
 
+	// The stack exists from here on, so the state is always set, also when its effective settings can't be resolved.
+	resp.Diagnostics.Append(r.updateEffectiveConfig(ctx, &plan)...)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -289,6 +465,12 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan stack.ResourceModel
 	var state stack.ResourceModel
@@ -302,6 +484,12 @@ func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := stack.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -312,10 +500,12 @@ func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update stack %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update stack %s", id), err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateEffectiveConfig(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -328,6 +518,12 @@ func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state stack.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -336,8 +532,19 @@ func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
+	resp.Diagnostics.Append(deletionProtectionDeleteDiagnostics("stack", id, state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.Client.stack.DeleteStack(ctx, id)
 
 	if err != nil {
@@ -346,10 +553,7 @@ func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Stack deletion failed",
-			fmt.Sprintf("Failed to delete stack %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Stack deletion failed", fmt.Sprintf("Failed to delete stack %s", id), err)...)
 		return
 	}
 }
@@ -357,3 +561,44 @@ func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// updateEffectiveConfig resolves the effective settings of the stack against its namespace and the org configuration,
+// which are read once per run for all the stacks. The stack itself doesn't depend on them, so when they can't be read
+// the effective settings are null and a warning is returned instead of an error, by the first stack only.
+func (r *StackResource) updateEffectiveConfig(ctx context.Context, state *stack.ResourceModel) diag.Diagnostics {
+	var retVal diag.Diagnostics
+
+	namespaceId := state.NamespaceId.ValueString()
+	ns, first, err := r.client.effectiveSettings.namespace(ctx, r.client.Client, namespaceId)
+	if err != nil {
+		stack.NullEffectiveConfig(state)
+		if first {
+			retVal.AddWarning(effectiveConfigNotResolvedWarning, fmt.Sprintf("Failed to read namespace %s, the effective settings of its stacks are null: %s", namespaceId, err))
+		}
+		return retVal
+	}
+
+	org, first, err := r.client.effectiveSettings.orgConfiguration(ctx, r.client.Client)
+	if err != nil {
+		if !commons.IsNotFoundResponseError(err) {
+			stack.NullEffectiveConfig(state)
+			if first {
+				retVal.AddWarning(effectiveConfigNotResolvedWarning, fmt.Sprintf("Failed to read the org configuration, the effective settings of the stacks are null: %s", err))
+			}
+			return retVal
+		}
+
+		org = nil
+	}
+
+	if diags := stack.UpdateEffectiveConfig(ctx, ns, org, state); diags.HasError() {
+		stack.NullEffectiveConfig(state)
+		retVal.Append(diags...)
+	}
+
+	return retVal
+}
+
+//endregion
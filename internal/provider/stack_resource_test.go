@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
@@ -17,7 +18,7 @@ const (
 
 	s1ResourceName              = "stack1"
 	s1IacType                   = "terraform"
-	s1Name                      = "stack1"
+	s1Name                      = test_helpers.NamePrefix + "stack1"
 	s1Description               = "hi"
 	s1DeployOnPush              = "false"
 	s1WaitForApproval           = "true"
@@ -26,7 +27,7 @@ const (
 	s1PolicyTtlType             = "hours"
 	s1PolicyTtlValue            = "1"
 
-	s1NameAfterUpdate             = "stack2"
+	s1NameAfterUpdate             = test_helpers.NamePrefix + "stack2"
 	s1IacTypeAfterUpdate          = "terragrunt"
 	s1TerrgruntVersionAfterUpdate = "0.45.3"
 )
@@ -86,6 +87,8 @@ resource "%s" "%s" {
 					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "run_trigger.patterns.0", s1RunTriggerPatternsElement),
 					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "policy.ttl_config.ttl.type", s1PolicyTtlType),
 					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "policy.ttl_config.ttl.value", s1PolicyTtlValue),
+					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "effective_iac_config.terraform_version", s1TerraformVersion),
+					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "effective_iac_config.source", "stack"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet(stackResourceName(s1ResourceName), "id"),
 				),
@@ -317,6 +320,58 @@ resource "%s" "%s" {
 	})
 }
 
+func TestAccStackResourceDeletionProtection(t *testing.T) {
+	providerId := test_config.GetProviderId()
+	repoName := test_config.GetRepoName()
+
+	protectedConfig := func(namespace string, deletionProtection bool) string {
+		return testAccStackResourceSetup() + fmt.Sprintf(`
+resource "cm_namespace" "other_namespace" {
+  name = "`+test_helpers.NamePrefix+`Stack Other Namespace"
+}
+
+resource "%s" "%s" {
+ iac_type = "%s"
+ namespace_id = cm_namespace.%s.id
+ name = "%s"
+ deployment_behavior = {
+   deploy_on_push = %s
+ }
+ vcs_info = {
+   provider_id = "%s"
+   repo_name = "%s"
+ }
+ deletion_protection = %t
+}
+`, cmStack, s1ResourceName, s1IacType, namespace, s1Name, s1DeployOnPush, providerId, repoName, deletionProtection)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: protectedConfig("test_namespace", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "deletion_protection", "true"),
+				),
+			},
+			// Moving the stack to another namespace replaces it, which fails when planned.
+			{
+				Config:      protectedConfig("other_namespace", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Deletion protection is enabled"),
+			},
+			{
+				Config: protectedConfig("test_namespace", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stackResourceName(s1ResourceName), "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func stackResourceName(s string) string {
 	return fmt.Sprintf("%s.%s", cmStack, s)
 }
@@ -324,7 +379,7 @@ func stackResourceName(s string) string {
 func testAccStackResourceSetup() string {
 	return providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "test_namespace" {
-  name = "Stack Namespace"
+  name = "`+test_helpers.NamePrefix+`Stack Namespace"
 }
 `)
 }
@@ -0,0 +1,843 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/control_policy_group"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace_permissions"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/team"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/template"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/variable"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The sweepers delete the objects that aborted acceptance tests left behind, i.e. objects whose name starts with
+// test_helpers.NamePrefix, and the mappings, permissions and variables attached to them. Run them with 'make sweep'.
+//
+// cm_stack_dependency, cm_stack_discovery_configuration and cm_disaster_recovery_configuration can't be listed, they
+// are deleted with the stacks and namespaces they belong to. cm_org_configuration is never created by name.
+func init() {
+	resource.AddTestSweepers("cm_template_namespace_mappings", &resource.Sweeper{
+		Name: "cm_template_namespace_mappings",
+		F:    sweepTemplateNamespaceMappings,
+	})
+	resource.AddTestSweepers("cm_blueprint_namespace_mappings", &resource.Sweeper{
+		Name: "cm_blueprint_namespace_mappings",
+		F:    sweepBlueprintNamespaceMappings,
+	})
+	resource.AddTestSweepers("cm_control_policy_mappings", &resource.Sweeper{
+		Name: "cm_control_policy_mappings",
+		F:    sweepControlPolicyMappings,
+	})
+	resource.AddTestSweepers("cm_control_policy_group_mappings", &resource.Sweeper{
+		Name: "cm_control_policy_group_mappings",
+		F:    sweepControlPolicyGroupMappings,
+	})
+	resource.AddTestSweepers("cm_namespace_permissions", &resource.Sweeper{
+		Name: "cm_namespace_permissions",
+		F:    sweepNamespacePermissions,
+	})
+	resource.AddTestSweepers("cm_team_users", &resource.Sweeper{
+		Name: "cm_team_users",
+		F:    sweepTeamUsers,
+	})
+	resource.AddTestSweepers("cm_events_subscriptions", &resource.Sweeper{
+		Name: "cm_events_subscriptions",
+		F:    sweepEventsSubscriptions,
+	})
+	resource.AddTestSweepers("cm_variable", &resource.Sweeper{
+		Name: "cm_variable",
+		F:    sweepVariables,
+	})
+	resource.AddTestSweepers("cm_stack", &resource.Sweeper{
+		Name: "cm_stack",
+		Dependencies: []string{
+			"cm_variable",
+			"cm_control_policy_mappings",
+			"cm_control_policy_group_mappings",
+		},
+		F: sweepStacks,
+	})
+	resource.AddTestSweepers("cm_namespace", &resource.Sweeper{
+		Name: "cm_namespace",
+		Dependencies: []string{
+			"cm_stack",
+			"cm_variable",
+			"cm_namespace_permissions",
+			"cm_events_subscriptions",
+			"cm_template_namespace_mappings",
+			"cm_blueprint_namespace_mappings",
+			"cm_control_policy_mappings",
+			"cm_control_policy_group_mappings",
+		},
+		F: sweepNamespaces,
+	})
+	resource.AddTestSweepers("cm_template", &resource.Sweeper{
+		Name:         "cm_template",
+		Dependencies: []string{"cm_template_namespace_mappings"},
+		F:            sweepTemplates,
+	})
+	resource.AddTestSweepers("cm_blueprint", &resource.Sweeper{
+		Name:         "cm_blueprint",
+		Dependencies: []string{"cm_blueprint_namespace_mappings"},
+		F:            sweepBlueprints,
+	})
+	resource.AddTestSweepers("cm_control_policy_group", &resource.Sweeper{
+		Name:         "cm_control_policy_group",
+		Dependencies: []string{"cm_control_policy_group_mappings"},
+		F:            sweepControlPolicyGroups,
+	})
+	resource.AddTestSweepers("cm_control_policy", &resource.Sweeper{
+		Name:         "cm_control_policy",
+		Dependencies: []string{"cm_control_policy_mappings", "cm_control_policy_group"},
+		F:            sweepControlPolicies,
+	})
+	resource.AddTestSweepers("cm_custom_abac_configuration", &resource.Sweeper{
+		Name: "cm_custom_abac_configuration",
+		F:    sweepCustomAbacConfigurations,
+	})
+	resource.AddTestSweepers("cm_team", &resource.Sweeper{
+		Name:         "cm_team",
+		Dependencies: []string{"cm_team_users", "cm_namespace_permissions", "cm_custom_abac_configuration"},
+		F:            sweepTeams,
+	})
+	resource.AddTestSweepers("cm_custom_role", &resource.Sweeper{
+		Name:         "cm_custom_role",
+		Dependencies: []string{"cm_namespace_permissions"},
+		F:            sweepCustomRoles,
+	})
+	resource.AddTestSweepers("cm_notification_endpoint", &resource.Sweeper{
+		Name:         "cm_notification_endpoint",
+		Dependencies: []string{"cm_events_subscriptions"},
+		F:            sweepNotificationEndpoints,
+	})
+	resource.AddTestSweepers("cm_notification_slack_app", &resource.Sweeper{
+		Name:         "cm_notification_slack_app",
+		Dependencies: []string{"cm_notification_endpoint"},
+		F:            sweepNotificationSlackApps,
+	})
+}
+
+//region Mappings
+
+func sweepTemplateNamespaceMappings(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	templates, err := client.template.ListTemplates(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	var errs []error
+
+	for _, t := range templates {
+		mappings, err := client.template.ListTemplateNamespaceMappings(ctx, controlmonkey.StringValue(t.ID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list namespace mappings of template %s: %w", controlmonkey.StringValue(t.ID), err))
+			continue
+		}
+
+		for _, m := range mappings {
+			if !isSweepable(t.Name) && !namespaceIds[controlmonkey.StringValue(m.NamespaceId)] {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting mapping of template %s to namespace %s", controlmonkey.StringValue(t.ID), controlmonkey.StringValue(m.NamespaceId))
+			input := &template.TemplateNamespaceMapping{TemplateId: t.ID, NamespaceId: m.NamespaceId}
+			if _, err := client.template.DeleteTemplateNamespaceMapping(ctx, input); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete mapping of template %s to namespace %s: %w", controlmonkey.StringValue(t.ID), controlmonkey.StringValue(m.NamespaceId), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepBlueprintNamespaceMappings(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	blueprints, err := client.blueprint.ListBlueprints(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list blueprints: %w", err)
+	}
+
+	var errs []error
+
+	for _, b := range blueprints {
+		mappings, err := client.blueprint.ListBlueprintNamespaceMappings(ctx, controlmonkey.StringValue(b.ID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list namespace mappings of blueprint %s: %w", controlmonkey.StringValue(b.ID), err))
+			continue
+		}
+
+		for _, m := range mappings {
+			if !isSweepable(b.Name) && !namespaceIds[controlmonkey.StringValue(m.NamespaceId)] {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting mapping of blueprint %s to namespace %s", controlmonkey.StringValue(b.ID), controlmonkey.StringValue(m.NamespaceId))
+			input := &blueprint.BlueprintNamespaceMapping{BlueprintId: b.ID, NamespaceId: m.NamespaceId}
+			if _, err := client.blueprint.DeleteBlueprintNamespaceMapping(ctx, input); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete mapping of blueprint %s to namespace %s: %w", controlmonkey.StringValue(b.ID), controlmonkey.StringValue(m.NamespaceId), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepControlPolicyMappings(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	targetIds, err := sweepableTargetIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	policies, err := client.controlPolicy.ListControlPolicies(ctx, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list control policies: %w", err)
+	}
+
+	var errs []error
+
+	for _, p := range policies {
+		mappings, err := client.controlPolicy.ListControlPolicyMappings(ctx, controlmonkey.StringValue(p.ID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list mappings of control policy %s: %w", controlmonkey.StringValue(p.ID), err))
+			continue
+		}
+
+		for _, m := range mappings {
+			if !isSweepable(p.Name) && !targetIds[controlmonkey.StringValue(m.TargetId)] {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting mapping of control policy %s to %s", controlmonkey.StringValue(p.ID), controlmonkey.StringValue(m.TargetId))
+			input := &control_policy.ControlPolicyMapping{ControlPolicyId: p.ID, TargetId: m.TargetId, TargetType: m.TargetType}
+			if _, err := client.controlPolicy.DeleteControlPolicyMapping(ctx, input); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete mapping of control policy %s to %s: %w", controlmonkey.StringValue(p.ID), controlmonkey.StringValue(m.TargetId), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepControlPolicyGroupMappings(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	targetIds, err := sweepableTargetIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	groups, err := client.controlPolicyGroup.ListControlPolicyGroups(ctx, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list control policy groups: %w", err)
+	}
+
+	var errs []error
+
+	for _, g := range groups {
+		mappings, err := client.controlPolicyGroup.ListControlPolicyGroupMappings(ctx, controlmonkey.StringValue(g.ID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list mappings of control policy group %s: %w", controlmonkey.StringValue(g.ID), err))
+			continue
+		}
+
+		for _, m := range mappings {
+			if !isSweepable(g.Name) && !targetIds[controlmonkey.StringValue(m.TargetId)] {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting mapping of control policy group %s to %s", controlmonkey.StringValue(g.ID), controlmonkey.StringValue(m.TargetId))
+			input := &control_policy_group.ControlPolicyGroupMapping{ControlPolicyGroupId: g.ID, TargetId: m.TargetId, TargetType: m.TargetType}
+			if _, err := client.controlPolicyGroup.DeleteControlPolicyGroupMapping(ctx, input); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete mapping of control policy group %s to %s: %w", controlmonkey.StringValue(g.ID), controlmonkey.StringValue(m.TargetId), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepNamespacePermissions(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for namespaceId := range namespaceIds {
+		permissions, err := client.namespacePermissions.ListNamespacePermissions(ctx, namespaceId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list permissions of namespace %s: %w", namespaceId, err))
+			continue
+		}
+
+		for _, p := range permissions {
+			log.Printf("[INFO] Deleting permission of namespace %s", namespaceId)
+			input := &namespace_permissions.NamespacePermission{
+				NamespaceId:          controlmonkey.String(namespaceId),
+				UserEmail:            p.UserEmail,
+				ProgrammaticUserName: p.ProgrammaticUserName,
+				TeamId:               p.TeamId,
+			}
+			if _, err := client.namespacePermissions.DeleteNamespacePermission(ctx, input); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete permission of namespace %s: %w", namespaceId, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepTeamUsers(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	teams, err := client.team.ListTeams(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	var errs []error
+
+	for _, t := range teams {
+		if !isSweepable(t.Name) {
+			continue
+		}
+
+		users, err := client.team.ListTeamUsers(ctx, controlmonkey.StringValue(t.ID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list users of team %s: %w", controlmonkey.StringValue(t.ID), err))
+			continue
+		}
+
+		for _, u := range users {
+			log.Printf("[INFO] Deleting user %s of team %s", controlmonkey.StringValue(u.UserEmail), controlmonkey.StringValue(t.ID))
+			input := &team.TeamUser{TeamId: t.ID, UserEmail: u.UserEmail}
+			if _, err := client.team.DeleteTeamUser(ctx, input); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete user %s of team %s: %w", controlmonkey.StringValue(u.UserEmail), controlmonkey.StringValue(t.ID), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepEventsSubscriptions(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	endpoints, err := client.notification.ListNotificationEndpoints(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list notification endpoints: %w", err)
+	}
+
+	endpointIds := make(map[string]bool)
+	for _, e := range endpoints {
+		if isSweepable(e.Name) {
+			endpointIds[controlmonkey.StringValue(e.ID)] = true
+		}
+	}
+
+	var errs []error
+
+	sweep := func(scope string, scopeId *string) {
+		subscriptions, err := client.notification.ListEventSubscriptions(ctx, scope, scopeId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list %s events subscriptions: %w", scope, err))
+			return
+		}
+
+		for _, s := range subscriptions {
+			if scopeId == nil && !endpointIds[controlmonkey.StringValue(s.NotificationEndpointId)] {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting events subscription %s", controlmonkey.StringValue(s.ID))
+			if _, err := client.notification.DeleteEventSubscription(ctx, controlmonkey.StringValue(s.ID)); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete events subscription %s: %w", controlmonkey.StringValue(s.ID), err))
+			}
+		}
+	}
+
+	for namespaceId := range namespaceIds {
+		sweep(cmTypes.NamespaceScope, controlmonkey.String(namespaceId))
+	}
+	sweep(cmTypes.OrganizationScope, nil)
+
+	return errors.Join(errs...)
+}
+
+func sweepVariables(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	stackIds, err := sweepableStackIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var inputs []*variable.ListVariablesInput
+	for namespaceId := range namespaceIds {
+		inputs = append(inputs, &variable.ListVariablesInput{NamespaceId: controlmonkey.String(namespaceId)})
+	}
+	for stackId := range stackIds {
+		inputs = append(inputs, &variable.ListVariablesInput{StackId: controlmonkey.String(stackId)})
+	}
+
+	var errs []error
+
+	for _, input := range inputs {
+		output, err := client.variable.ListVariables(ctx, input)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list variables: %w", err))
+			continue
+		}
+
+		for _, v := range output.Variables {
+			// Variables inherited from the org or the namespace are listed too, only those of the scope are swept.
+			if !namespaceIds[controlmonkey.StringValue(v.ScopeId)] && !stackIds[controlmonkey.StringValue(v.ScopeId)] {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting variable %s", controlmonkey.StringValue(v.ID))
+			if _, err := client.variable.DeleteVariable(ctx, &variable.DeleteVariableInput{VariableId: v.ID}); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete variable %s: %w", controlmonkey.StringValue(v.ID), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+//endregion
+
+//region Entities
+
+func sweepStacks(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	stackIds, err := sweepableStackIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	return sweepIds(stackIds, "stack", func(id string) error {
+		_, err := client.stack.DeleteStack(ctx, id)
+		return err
+	})
+}
+
+func sweepNamespaces(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	return sweepIds(namespaceIds, "namespace", func(id string) error {
+		_, err := client.namespace.DeleteNamespace(ctx, id)
+		return err
+	})
+}
+
+func sweepTemplates(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	templates, err := client.template.ListTemplates(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, t := range templates {
+		if isSweepable(t.Name) {
+			ids[controlmonkey.StringValue(t.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "template", func(id string) error {
+		_, err := client.template.DeleteTemplate(ctx, id)
+		return err
+	})
+}
+
+func sweepBlueprints(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	blueprints, err := client.blueprint.ListBlueprints(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list blueprints: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, b := range blueprints {
+		if isSweepable(b.Name) {
+			ids[controlmonkey.StringValue(b.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "blueprint", func(id string) error {
+		_, err := client.blueprint.DeleteBlueprint(ctx, id)
+		return err
+	})
+}
+
+func sweepControlPolicyGroups(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	groups, err := client.controlPolicyGroup.ListControlPolicyGroups(ctx, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list control policy groups: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, g := range groups {
+		if isSweepable(g.Name) {
+			ids[controlmonkey.StringValue(g.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "control policy group", func(id string) error {
+		_, err := client.controlPolicyGroup.DeleteControlPolicyGroup(ctx, id)
+		return err
+	})
+}
+
+func sweepControlPolicies(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	policies, err := client.controlPolicy.ListControlPolicies(ctx, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list control policies: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, p := range policies {
+		if isSweepable(p.Name) {
+			ids[controlmonkey.StringValue(p.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "control policy", func(id string) error {
+		_, err := client.controlPolicy.DeleteControlPolicy(ctx, id)
+		return err
+	})
+}
+
+func sweepCustomAbacConfigurations(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	configurations, err := client.customAbacConfiguration.ListCustomAbacConfigurations(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list custom ABAC configurations: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, c := range configurations {
+		if isSweepable(c.Name) {
+			ids[controlmonkey.StringValue(c.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "custom ABAC configuration", func(id string) error {
+		_, err := client.customAbacConfiguration.DeleteCustomAbacConfiguration(ctx, id)
+		return err
+	})
+}
+
+func sweepTeams(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	teams, err := client.team.ListTeams(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, t := range teams {
+		if isSweepable(t.Name) {
+			ids[controlmonkey.StringValue(t.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "team", func(id string) error {
+		_, err := client.team.DeleteTeam(ctx, id)
+		return err
+	})
+}
+
+func sweepCustomRoles(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	roles, err := client.customRole.ListCustomRoles(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list custom roles: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, r := range roles {
+		if isSweepable(r.Name) {
+			ids[controlmonkey.StringValue(r.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "custom role", func(id string) error {
+		_, err := client.customRole.DeleteCustomRole(ctx, id)
+		return err
+	})
+}
+
+func sweepNotificationEndpoints(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	endpoints, err := client.notification.ListNotificationEndpoints(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list notification endpoints: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, e := range endpoints {
+		if isSweepable(e.Name) {
+			ids[controlmonkey.StringValue(e.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "notification endpoint", func(id string) error {
+		_, err := client.notification.DeleteNotificationEndpoint(ctx, id)
+		return err
+	})
+}
+
+func sweepNotificationSlackApps(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	slackApps, err := client.notification.ListNotificationSlackApps(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list notification slack apps: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, s := range slackApps {
+		if isSweepable(s.Name) {
+			ids[controlmonkey.StringValue(s.ID)] = true
+		}
+	}
+
+	return sweepIds(ids, "notification slack app", func(id string) error {
+		_, err := client.notification.DeleteNotificationSlackApp(ctx, id)
+		return err
+	})
+}
+
+//endregion
+
+//region Private
+
+// sweeperRequestsPerSecond keeps the sweepers, which delete many objects at once, within the rate limits of the API.
+const sweeperRequestsPerSecond = 10
+
+// sweeperClient returns a client for the organization of the CONTROL_MONKEY_TOKEN environment variable.
+func sweeperClient() (*Client, error) {
+	config := Config{
+		Token:             os.Getenv(credentials.EnvCredentialsVarToken),
+		Endpoint:          os.Getenv(EndpointEnvVar),
+		RequestsPerSecond: sweeperRequestsPerSecond,
+		Burst:             defaultBurst,
+	}
+
+	retVal, diags := config.Client()
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to create a ControlMonkey client: %s", diags.Errors()[0].Detail())
+	}
+
+	return retVal, nil
+}
+
+func isSweepable(name *string) bool {
+	return strings.HasPrefix(controlmonkey.StringValue(name), test_helpers.NamePrefix)
+}
+
+func sweepableNamespaceIds(ctx context.Context, client *Client) (map[string]bool, error) {
+	namespaces, err := client.namespace.ListNamespaces(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	retVal := make(map[string]bool)
+	for _, n := range namespaces {
+		if isSweepable(n.Name) {
+			retVal[controlmonkey.StringValue(n.ID)] = true
+		}
+	}
+
+	return retVal, nil
+}
+
+// sweepableStackIds returns the stacks whose name starts with the prefix, and the stacks of the namespaces that do.
+func sweepableStackIds(ctx context.Context, client *Client) (map[string]bool, error) {
+	namespaceIds, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	stacks, err := client.stack.ListStacks(ctx, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stacks: %w", err)
+	}
+
+	retVal := make(map[string]bool)
+	for _, s := range stacks {
+		if isSweepable(s.Name) || namespaceIds[controlmonkey.StringValue(s.NamespaceId)] {
+			retVal[controlmonkey.StringValue(s.ID)] = true
+		}
+	}
+
+	return retVal, nil
+}
+
+// sweepableTargetIds returns the namespaces and stacks that control policies may be mapped to.
+func sweepableTargetIds(ctx context.Context, client *Client) (map[string]bool, error) {
+	retVal, err := sweepableNamespaceIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	stackIds, err := sweepableStackIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for id := range stackIds {
+		retVal[id] = true
+	}
+
+	return retVal, nil
+}
+
+func sweepIds(ids map[string]bool, entityName string, deleteFunc func(id string) error) error {
+	var errs []error
+
+	for id := range ids {
+		log.Printf("[INFO] Deleting %s %s", entityName, id)
+		if err := deleteFunc(id); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete %s %s: %w", entityName, id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+//endregion
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -15,7 +16,7 @@ func TestAccTeamDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_team" "team" {
-  name = "Team Unique"
+  name = "`+test_helpers.NamePrefix+`Team Unique"
 }
 
 data "cm_team" "team" {
@@ -23,7 +24,7 @@ data "cm_team" "team" {
 }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.cm_team.team", "id"),
-					resource.TestCheckResourceAttr("data.cm_team.team", "name", "Team Unique"),
+					resource.TestCheckResourceAttr("data.cm_team.team", "name", test_helpers.NamePrefix+"Team Unique"),
 				),
 			},
 		},
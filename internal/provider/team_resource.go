@@ -7,8 +7,10 @@ import (
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/team"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	sdkTeam "github.com/control-monkey/controlmonkey-sdk-go/services/team"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	cmStringValidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +35,7 @@ func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_team"
 }
 
-func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TeamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys teams.",
 		Attributes: map[string]schema.Attribute{
@@ -58,6 +60,10 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					cmStringValidators.NotBlank(),
 				},
 			},
+			adoptExistingAttributeName: adoptExistingAttribute("team", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -85,6 +91,10 @@ func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state team.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -93,6 +103,12 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.team.ReadTeam(ctx, id)
 	if err != nil {
@@ -101,7 +117,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read team %s", id), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read team %s", id), "", err)...)
 		return
 	}
 
@@ -117,6 +133,12 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan team.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -125,14 +147,31 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := team.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.team.CreateTeam(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Team creation failed",
-			fmt.Sprintf("failed to create team, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, "Team creation failed", "failed to create team", err)...)
 		return
 	}
 
@@ -147,6 +186,12 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan team.ResourceModel
 	var state team.ResourceModel
@@ -160,6 +205,12 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := team.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -170,10 +221,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update team %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update team %s", id), err)...)
 		return
 	}
 
@@ -186,6 +234,12 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state team.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -194,6 +248,12 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	_, err := r.client.Client.team.DeleteTeam(ctx, id)
 
@@ -203,10 +263,7 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Team deletion failed",
-			fmt.Sprintf("Failed to delete team %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Team deletion failed", fmt.Sprintf("Failed to delete team %s", id), err)...)
 		return
 	}
 }
@@ -214,3 +271,40 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing team with the planned name and applies the plan to it. It returns
+// false when there is no such team.
+func (r *TeamResource) adoptExisting(ctx context.Context, plan *team.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkTeam.Team]{
+		Kind: "team",
+		List: func(ctx context.Context) ([]*sdkTeam.Team, error) {
+			return r.client.Client.team.ListTeams(ctx, nil, plan.Name.ValueStringPointer())
+		},
+		Match: func(e *sdkTeam.Team) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkTeam.Team) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.team.ReadTeam,
+		Update: func(ctx context.Context, id string, existing *sdkTeam.Team) error {
+			var state team.ResourceModel
+			team.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := team.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.team.UpdateTeam(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
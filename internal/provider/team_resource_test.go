@@ -2,8 +2,10 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -11,10 +13,11 @@ const (
 	cmTeam = "cm_team"
 
 	teamResourceName = "team"
-	teamName         = "Dev Team"
+	teamName         = test_helpers.NamePrefix + "Dev Team"
 	teamCustomIdpId  = "t123"
+	teamTimeout      = "2m"
 
-	teamNameAfterUpdate = "Prod team"
+	teamNameAfterUpdate = test_helpers.NamePrefix + "Prod team"
 )
 
 func TestAccTeamResource(t *testing.T) {
@@ -27,11 +30,15 @@ func TestAccTeamResource(t *testing.T) {
 resource "%s" "%s" {
  name = "%s"
  custom_idp_id = "%s"
+ timeouts {
+   create = "%s"
+ }
 }
-`, cmTeam, teamResourceName, teamName, teamCustomIdpId),
+`, cmTeam, teamResourceName, teamName, teamCustomIdpId, teamTimeout),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(teamResource(teamResourceName), "name", teamName),
 					resource.TestCheckResourceAttr(teamResource(teamResourceName), "custom_idp_id", teamCustomIdpId),
+					resource.TestCheckResourceAttr(teamResource(teamResourceName), "timeouts.create", teamTimeout),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet(teamResource(teamResourceName), "id"),
 				),
@@ -58,6 +65,61 @@ resource "%s" "%s" {
 	})
 }
 
+func TestAccTeamResourceAdoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "%s" "%s" {
+ name = "%s"
+}
+`, cmTeam, teamResourceName, teamName),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "%s" "%s" {
+ name          = "%s"
+ custom_idp_id = "%s"
+}
+
+resource "%s" "adopted" {
+ name           = "%s"
+ custom_idp_id  = "%s"
+ adopt_existing = true
+}
+`, cmTeam, teamResourceName, teamName, teamCustomIdpId, cmTeam, teamName, teamCustomIdpId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(teamResource("adopted"), "id", teamResource(teamResourceName), "id"),
+					resource.TestCheckResourceAttr(teamResource("adopted"), "custom_idp_id", teamCustomIdpId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeamResourceReadOnlyProvider(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "cm" {
+ read_only = true
+}
+
+resource "%s" "%s" {
+ name = "%s"
+}
+`, cmTeam, teamResourceName, teamName),
+				ExpectError: regexp.MustCompile("Provider is read only"),
+			},
+		},
+	})
+}
+
 func teamResource(s string) string {
 	return fmt.Sprintf("%s.%s", cmTeam, s)
 }
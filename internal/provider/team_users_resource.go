@@ -41,7 +41,7 @@ func (r *TeamUsersResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_team_users"
 }
 
-func (r *TeamUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TeamUsersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys team users.",
 		Attributes: map[string]schema.Attribute{
@@ -93,6 +93,9 @@ func (r *TeamUsersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -138,6 +141,10 @@ func (r *TeamUsersResource) ValidateConfig(ctx context.Context, req resource.Val
 
 // Read refreshes the Terraform state with the latest data.
 func (r *TeamUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state teamUsers.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -146,6 +153,12 @@ func (r *TeamUsersResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.team.ListTeamUsers(ctx, id)
 	if err != nil {
@@ -155,7 +168,7 @@ func (r *TeamUsersResource) Read(ctx context.Context, req resource.ReadRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read users for team '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read users for team '%s'", id), "", err)...)
 		return
 	}
 
@@ -171,6 +184,12 @@ func (r *TeamUsersResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *TeamUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan teamUsers.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -179,6 +198,12 @@ func (r *TeamUsersResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := teamUsers.Merge(&plan, nil, commons.CreateMerger)
 	teamId := plan.TeamId
 
@@ -199,6 +224,12 @@ func (r *TeamUsersResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *TeamUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan teamUsers.ResourceModel
 	var state teamUsers.ResourceModel
@@ -212,6 +243,12 @@ func (r *TeamUsersResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := teamUsers.Merge(&plan, &state, commons.UpdateMerger)
 
 	diags = r.createEntities(ctx, mergeResult.EntitiesToCreate, plan.TeamId.ValueString())
@@ -235,6 +272,12 @@ func (r *TeamUsersResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *TeamUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state teamUsers.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -243,6 +286,12 @@ func (r *TeamUsersResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := teamUsers.Merge(nil, &state, commons.DeleteMerger)
 
 	r.deleteEntities(ctx, mergeResult.EntitiesToDelete, state.TeamId.ValueString())
@@ -268,14 +317,9 @@ func (r *TeamUsersResource) createEntities(ctx context.Context, entitiesToCreate
 			if commons.IsAlreadyExistResponseError(err) {
 				tflog.Info(ctx, fmt.Sprintf("User '%s' is already in team '%s'. No operation was made.", userId, teamId))
 			} else if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to add user '%s' to team '%s'. Error: %s",
-						userId, teamId, err.Error())),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to add user '%s' to team '%s'", userId, teamId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to add user '%s' to team '%s'", userId, teamId), err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to add user '%s' to team '%s'", userId, teamId), "", err)
 			}
 		}
 	}
@@ -294,14 +338,9 @@ func (r *TeamUsersResource) deleteEntities(ctx context.Context, entitiesToDelete
 		if err != nil {
 			userId := *e.UserEmail
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to remove user '%s' from team '%s'. Error: %s",
-						userId, teamId, err.Error())),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to remove user '%s' from team '%s'", userId, teamId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to remove user '%s' from team '%s'", userId, teamId), err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to remove user '%s' from team '%s'", userId, teamId), "", err)
 			}
 		}
 	}
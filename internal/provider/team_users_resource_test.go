@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -17,7 +18,7 @@ const (
 func testAccTeamUsersResourceSetup() string {
 	return `
 resource "cm_team" "test_team" {
-  name = "TestTeam"
+  name = "` + test_helpers.NamePrefix + `TestTeam"
 }
 `
 }
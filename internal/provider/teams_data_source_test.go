@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "cm_team" "team" {
+  name = "`+test_helpers.NamePrefix+`Teams Data Source Unique"
+}
+data "cm_teams" "teams" {
+  name_regex = "^`+test_helpers.NamePrefix+`Teams Data Source"
+
+  depends_on = [cm_team.team]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_teams.teams", "teams.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_teams.teams", "teams.0.id"),
+					resource.TestCheckResourceAttr("data.cm_teams.teams", "teams.0.name", test_helpers.NamePrefix+"Teams Data Source Unique"),
+				),
+			},
+		},
+	})
+}
@@ -26,6 +26,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &TemplateNamespaceMappingsResource{}
+var _ resource.ResourceWithModifyPlan = &TemplateNamespaceMappingsResource{}
 
 func NewTemplateNamespaceMappingsResource() resource.Resource {
 	return &TemplateNamespaceMappingsResource{}
@@ -39,7 +40,7 @@ func (r *TemplateNamespaceMappingsResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_template_namespace_mappings"
 }
 
-func (r *TemplateNamespaceMappingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TemplateNamespaceMappingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys template namespaces.",
 		Attributes: map[string]schema.Attribute{
@@ -91,6 +92,9 @@ func (r *TemplateNamespaceMappingsResource) Schema(_ context.Context, _ resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -134,8 +138,28 @@ func (r *TemplateNamespaceMappingsResource) ValidateConfig(ctx context.Context,
 	}
 }
 
+// ModifyPlan fails when the template namespace mapping is planned in a namespace that is not allowed by the provider configuration.
+func (r *TemplateNamespaceMappingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.client.hasNamespaceRestrictions() {
+		return
+	}
+
+	var plan templateNamespaces.ResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	for _, namespace := range plan.Namespaces {
+		resp.Diagnostics.Append(r.client.namespaceNotAllowedDiagnostics(ctx, path.Root("namespaces"), namespace.NamespaceId)...)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *TemplateNamespaceMappingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state templateNamespaces.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -144,6 +168,12 @@ func (r *TemplateNamespaceMappingsResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.template.ListTemplateNamespaceMappings(ctx, id)
 	if err != nil {
@@ -153,7 +183,7 @@ func (r *TemplateNamespaceMappingsResource) Read(ctx context.Context, req resour
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read template-namespace mappings for template '%s'", id), err.Error())
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read template-namespace mappings for template '%s'", id), "", err)...)
 		return
 	}
 
@@ -169,6 +199,12 @@ func (r *TemplateNamespaceMappingsResource) Read(ctx context.Context, req resour
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *TemplateNamespaceMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan templateNamespaces.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -177,6 +213,12 @@ func (r *TemplateNamespaceMappingsResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := templateNamespaces.Merge(&plan, nil, commons.CreateMerger)
 	templateId := plan.TemplateId
 
@@ -197,6 +239,12 @@ func (r *TemplateNamespaceMappingsResource) Create(ctx context.Context, req reso
 }
 
 func (r *TemplateNamespaceMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan templateNamespaces.ResourceModel
 	var state templateNamespaces.ResourceModel
@@ -210,6 +258,12 @@ func (r *TemplateNamespaceMappingsResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := templateNamespaces.Merge(&plan, &state, commons.UpdateMerger)
 
 	diags = r.createEntities(ctx, mergeResult.EntitiesToCreate, plan.TemplateId.ValueString())
@@ -233,6 +287,12 @@ func (r *TemplateNamespaceMappingsResource) Update(ctx context.Context, req reso
 }
 
 func (r *TemplateNamespaceMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state templateNamespaces.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -241,6 +301,12 @@ func (r *TemplateNamespaceMappingsResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mergeResult := templateNamespaces.Merge(nil, &state, commons.DeleteMerger)
 
 	diags = r.deleteEntities(ctx, mergeResult.EntitiesToDelete, state.TemplateId.ValueString())
@@ -266,14 +332,9 @@ func (r *TemplateNamespaceMappingsResource) createEntities(ctx context.Context,
 			if commons.IsAlreadyExistResponseError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Namespace '%s' is already mapped to template '%s'. No operation was made.", namespaceId, templateId))
 			} else if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to map namespace '%s' to template '%s'. Error: %s",
-						namespaceId, templateId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to map namespace '%s' to template '%s'", namespaceId, templateId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to map namespace '%s' to template '%s'", namespaceId, templateId), err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to map namespace '%s' to template '%s'", namespaceId, templateId), "", err)
 			}
 		}
 	}
@@ -292,15 +353,10 @@ func (r *TemplateNamespaceMappingsResource) deleteEntities(ctx context.Context,
 		if err != nil {
 			namespaceId := *e.NamespaceId
 			if commons.IsNotFoundResponseError(err) {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(resourceNotFoundError, fmt.Sprintf("Failed to delete mapping between namespace '%s' and template '%s'. Error: %s",
-						namespaceId, templateId, err)),
-				}
+				return commons.ApiErrorDiagnostics(ctx, resourceNotFoundError, fmt.Sprintf("Failed to delete mapping between namespace '%s' and template '%s'", namespaceId, templateId), err)
 			} else {
-				return diag.Diagnostics{
-					diag.NewErrorDiagnostic(fmt.Sprintf("Failed to delete mapping between namespace '%s' and template '%s'",
-						namespaceId, templateId), err.Error()),
-				}
+				return commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to delete mapping between namespace '%s' and template '%s'",
+					namespaceId, templateId), "", err)
 			}
 		}
 	}
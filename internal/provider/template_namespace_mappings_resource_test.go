@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -17,7 +18,7 @@ const (
 func testAccTemplateNamespaceMappingsResourceSetup(providerId string, repoName string) string {
 	return fmt.Sprintf(`
 resource "cm_template" "test_template" {
-  name                = "TestTemplate"
+  name                = "`+test_helpers.NamePrefix+`TestTemplate"
   iac_type = "terraform"
 
   vcs_info = {
@@ -41,15 +42,15 @@ resource "cm_template" "test_template" {
 }
 
 resource "cm_namespace" "test_mapping_namespace" {
-  name = "TestMappingNamespace"
+  name = "`+test_helpers.NamePrefix+`TestMappingNamespace"
 }
 
 resource "cm_namespace" "namespace"{
-  name = "Namespace Resource"
+  name = "`+test_helpers.NamePrefix+`Namespace Resource"
 }
 
 resource "cm_namespace" "namespace2" {
-  name = "Namespace Resource2"
+  name = "`+test_helpers.NamePrefix+`Namespace Resource2"
 }
 `, providerId, repoName)
 }
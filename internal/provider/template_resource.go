@@ -6,12 +6,14 @@ import (
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	sdkTemplate "github.com/control-monkey/controlmonkey-sdk-go/services/template"
 	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/cross_schema"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/entities/template"
 	cm_stringvalidators "github.com/control-monkey/terraform-provider-cm/internal/provider/validators/string"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +25,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &TemplateResource{}
+var _ resource.ResourceWithModifyPlan = &TemplateResource{}
 
 func NewTemplateResource() resource.Resource {
 	return &TemplateResource{}
@@ -36,7 +39,7 @@ func (r *TemplateResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_template"
 }
 
-func (r *TemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys templates for ephemeral stack. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/self-service-templates/template-for-ephemeral-stack)",
 		Attributes: map[string]schema.Attribute{
@@ -145,18 +148,32 @@ func (r *TemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"terraform_version": schema.StringAttribute{
 						MarkdownDescription: "the Terraform version that will be used for terraform operations.",
 						Optional:            true,
+						Validators: []validator.String{
+							cm_stringvalidators.IacVersion(),
+						},
 					},
 					"terragrunt_version": schema.StringAttribute{
 						MarkdownDescription: "the Terragrunt version that will be used for terragrunt operations.",
 						Optional:            true,
+						Validators: []validator.String{
+							cm_stringvalidators.IacVersion(),
+						},
 					},
 					"opentofu_version": schema.StringAttribute{
 						MarkdownDescription: "the OpenTofu version that will be used for tofu operations.",
 						Optional:            true,
+						Validators: []validator.String{
+							cm_stringvalidators.IacVersion(),
+						},
 					},
 				},
 			},
-			"runner_config": cross_schema.StackRunnerConfigSchema,
+			"runner_config":                 cross_schema.StackRunnerConfigSchema,
+			deletionProtectionAttributeName: deletionProtectionAttribute("template"),
+			adoptExistingAttributeName:      adoptExistingAttribute("template", "with the same name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -168,34 +185,15 @@ func (r *TemplateResource) ValidateConfig(ctx context.Context, req resource.Vali
 		return
 	}
 
-	runnerConfig := data.RunnerConfig
-
-	if runnerConfig != nil {
-		mode := runnerConfig.Mode
-
-		if helpers.IsKnown(mode) {
-			modeValue := mode.ValueString()
-
-			if modeValue == cmTypes.Managed && runnerConfig.Groups.IsNull() == false {
-				resp.Diagnostics.AddError(
-					validationError, fmt.Sprintf("runner_config.mode with type '%s' cannot have runner_config.groups", cmTypes.Managed),
-				)
-			} else if modeValue == cmTypes.SelfHosted && helpers.IsKnown(runnerConfig.Groups) {
-				if len(runnerConfig.Groups.Elements()) == 0 {
-					resp.Diagnostics.AddError(
-						validationError, fmt.Sprintf("runner_config.mode with type '%s' requires runner_config.groups to be not empty", cmTypes.SelfHosted),
-					)
-				} else if helpers.DoesTfListContainsEmptyValue(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found empty string in runner_config.groups",
-					)
-				} else if !helpers.IsTfStringSliceUnique(runnerConfig.Groups) {
-					resp.Diagnostics.AddError(
-						validationError, "Found duplicate in runner_config.groups",
-					)
-				}
-			}
+	if data.IacConfig != nil {
+		versions := iacVersions{
+			TerraformVersion:  data.IacConfig.TerraformVersion,
+			TerragruntVersion: data.IacConfig.TerragruntVersion,
+			OpentofuVersion:   data.IacConfig.OpentofuVersion,
 		}
+
+		resp.Diagnostics.Append(validateIacVersionsMatchIacType(path.Root("iac_config"), data.IacType, versions)...)
+		resp.Diagnostics.Append(r.client.iacVersionsBelowMinimumWarnings(path.Root("iac_config"), versions)...)
 	}
 }
 
@@ -220,8 +218,17 @@ func (r *TemplateResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ModifyPlan fails when a template with deletion protection would be destroyed or replaced.
+func (r *TemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(deletionProtectionPlanDiagnostics(ctx, "template", req)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	//Get current state
 	var state template.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -230,6 +237,12 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.template.ReadTemplate(ctx, id)
 	if err != nil {
@@ -238,7 +251,7 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read template %s", id), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read template %s", id), "", err)...)
 		return
 	}
 
@@ -254,6 +267,12 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *TemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan template.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -262,14 +281,31 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.shouldAdoptExisting(plan.AdoptExisting) {
+		adopted, diags := r.adoptExisting(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if adopted {
+			diags = resp.State.Set(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	body, _ := template.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.template.CreateTemplate(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Template creation failed",
-			fmt.Sprintf("failed to create template, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, "Template creation failed", "failed to create template", err)...)
 		return
 	}
 
@@ -284,6 +320,12 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan template.ResourceModel
 	var state template.ResourceModel
@@ -297,6 +339,12 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 	body, _ := template.Converter(&plan, &state, commons.UpdateConverter)
 
@@ -307,10 +355,7 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update template %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update template %s", id), err)...)
 		return
 	}
 
@@ -323,6 +368,12 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state template.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -331,8 +382,19 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
+	resp.Diagnostics.Append(deletionProtectionDeleteDiagnostics("template", id, state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.Client.template.DeleteTemplate(ctx, id)
 
 	if err != nil {
@@ -341,10 +403,7 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Template deletion failed",
-			fmt.Sprintf("Failed to delete template %s, error: %s", id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Template deletion failed", fmt.Sprintf("Failed to delete template %s", id), err)...)
 		return
 	}
 }
@@ -352,3 +411,40 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+//region Private
+
+// adoptExisting takes ownership of an existing template with the planned name and applies the plan to it. It returns
+// false when there is no such template.
+func (r *TemplateResource) adoptExisting(ctx context.Context, plan *template.ResourceModel) (bool, diag.Diagnostics) {
+	id, adopted, diags := commons.AdoptExisting(ctx, plan.Name.ValueString(), commons.ExistingEntity[*sdkTemplate.Template]{
+		Kind: "template",
+		List: func(ctx context.Context) ([]*sdkTemplate.Template, error) {
+			return r.client.Client.template.ListTemplates(ctx, nil, plan.Name.ValueStringPointer())
+		},
+		Match: func(e *sdkTemplate.Template) bool {
+			return controlmonkey.StringValue(e.Name) == plan.Name.ValueString()
+		},
+		ID:   func(e *sdkTemplate.Template) string { return controlmonkey.StringValue(e.ID) },
+		Read: r.client.Client.template.ReadTemplate,
+		Update: func(ctx context.Context, id string, existing *sdkTemplate.Template) error {
+			var state template.ResourceModel
+			template.UpdateStateAfterRead(existing, &state)
+
+			body, hasChanges := template.Converter(plan, &state, commons.UpdateConverter)
+			if !hasChanges {
+				return nil
+			}
+
+			_, err := r.client.Client.template.UpdateTemplate(ctx, id, body)
+			return err
+		},
+	})
+	if adopted {
+		plan.ID = types.StringValue(id)
+	}
+
+	return adopted, diags
+}
+
+//endregion
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -12,7 +13,7 @@ const (
 	cmTemplate = "cm_template"
 
 	t1ResourceName          = "template"
-	t1Name                  = "Dev Self-Service Template"
+	t1Name                  = test_helpers.NamePrefix + "Dev Self-Service Template"
 	t1IacType               = "terraform"
 	t1Description           = "Self service on Dev environment for developers"
 	t1PolicyMaxTtlType      = "days"
@@ -21,7 +22,7 @@ const (
 	t1PolicyDefaultTtlValue = "3"
 
 	t1PolicyDefaultTtlValueAfterUpdate = "1"
-	t1NameAfterUpdate                  = "Dev Self-Service Template After Update"
+	t1NameAfterUpdate                  = test_helpers.NamePrefix + "Dev Self-Service Template After Update"
 	t1IacTypeAfterUpdate               = "terragrunt"
 )
 
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTemplatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "cm_templates" "templates" {
+  name_regex = "^Template Unique$"
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cm_templates.templates", "templates.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cm_templates.templates", "templates.0.id"),
+					resource.TestCheckResourceAttr("data.cm_templates.templates", "templates.0.name", "Template Unique"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+func createTimeoutContext(ctx context.Context, t timeouts.Value, diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return timeoutContext(ctx, "create", t.Create, defaultCreateTimeout, diagnostics)
+}
+
+func readTimeoutContext(ctx context.Context, t timeouts.Value, diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return timeoutContext(ctx, "read", t.Read, defaultReadTimeout, diagnostics)
+}
+
+func updateTimeoutContext(ctx context.Context, t timeouts.Value, diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return timeoutContext(ctx, "update", t.Update, defaultUpdateTimeout, diagnostics)
+}
+
+func deleteTimeoutContext(ctx context.Context, t timeouts.Value, diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return timeoutContext(ctx, "delete", t.Delete, defaultDeleteTimeout, diagnostics)
+}
+
+// timeoutContext bounds ctx by the configured timeout of the operation. The returned cancel function must be deferred,
+// it adds a timeout diagnostic when the operation did not complete before the deadline.
+func timeoutContext(ctx context.Context, operation string, get func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	defaultTimeout time.Duration, diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, diags := get(ctx, defaultTimeout)
+	diagnostics.Append(diags...)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+
+	retVal := func() {
+		if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+			diagnostics.AddError(
+				operationTimeoutError,
+				fmt.Sprintf("The %s operation did not complete within %s. The timeout can be increased using the 'timeouts.%s' attribute.", operation, timeout, operation),
+			)
+		}
+
+		cancel()
+	}
+
+	return timeoutCtx, retVal
+}
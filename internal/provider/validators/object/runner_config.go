@@ -0,0 +1,75 @@
+package cm_objectvalidator
+
+import (
+	"context"
+	"fmt"
+
+	cmTypes "github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/terraform-provider-cm/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const validationError = "Validation Error"
+
+var _ validator.Object = runnerConfigValidator{}
+
+// runnerConfigValidator validates that the runner groups match the runner mode.
+type runnerConfigValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v runnerConfigValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("groups must contain unique non blank values, must not be empty when mode is '%s' and must not be set when mode is '%s'", cmTypes.SelfHosted, cmTypes.Managed)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v runnerConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateObject performs the validation.
+func (v runnerConfigValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	mode, _ := attributes["mode"].(types.String)
+	groups, _ := attributes["groups"].(types.List)
+
+	if !helpers.IsKnown(mode) {
+		return
+	}
+
+	modePath := req.Path.AtName("mode")
+	groupsPath := req.Path.AtName("groups")
+
+	// Groups that are known only after apply can't be checked, except that managed runners must not have any.
+	if mode.ValueString() == cmTypes.Managed && !groups.IsNull() {
+		resp.Diagnostics.AddAttributeError(groupsPath,
+			validationError, fmt.Sprintf("%s with type '%s' cannot have %s", modePath, cmTypes.Managed, groupsPath),
+		)
+	} else if mode.ValueString() == cmTypes.SelfHosted && helpers.IsKnown(groups) {
+		if len(groups.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(groupsPath,
+				validationError, fmt.Sprintf("%s with type '%s' requires %s to be not empty", modePath, cmTypes.SelfHosted, groupsPath),
+			)
+		} else if helpers.DoesTfListContainsEmptyValue(groups) {
+			resp.Diagnostics.AddAttributeError(groupsPath,
+				validationError, fmt.Sprintf("Found empty string in %s", groupsPath),
+			)
+		} else if !helpers.IsTfStringSliceUnique(groups) {
+			resp.Diagnostics.AddAttributeError(groupsPath,
+				validationError, fmt.Sprintf("Found duplicate in %s", groupsPath),
+			)
+		}
+	}
+}
+
+// RunnerConfig returns a validator which ensures that the groups of a runner_config object match its mode.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func RunnerConfig() validator.Object {
+	return runnerConfigValidator{}
+}
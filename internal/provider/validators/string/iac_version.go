@@ -0,0 +1,48 @@
+package cm_stringvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = iacVersionValidator{}
+
+// iacVersionValidator validates that the value is a version or a version constraint.
+type iacVersionValidator struct {
+}
+
+func (v iacVersionValidator) Description(_ context.Context) string {
+	return "value must be a version (e.g. 1.5.7) or a version constraint (e.g. ~> 1.5.0)"
+}
+
+func (v iacVersionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v iacVersionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue
+
+	if _, err := version.NewVersion(value.ValueString()); err == nil {
+		return
+	}
+
+	if _, err := version.NewConstraint(value.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value.String(),
+		))
+	}
+}
+
+// IacVersion checks that the String is a Terraform, Terragrunt or OpenTofu version or version constraint
+func IacVersion() validator.String {
+	return iacVersionValidator{}
+}
@@ -0,0 +1,45 @@
+package cm_stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validRegexValidator{}
+
+// validRegexValidator validates that the value is a valid regular expression.
+type validRegexValidator struct {
+}
+
+func (v validRegexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v validRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validRegexValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue
+
+	if _, err := regexp.Compile(value.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+			value.String(),
+		))
+	}
+}
+
+// ValidRegex checks that the String is a valid regular expression
+func ValidRegex() validator.String {
+	return validRegexValidator{}
+}
@@ -25,6 +25,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &VariableResource{}
+var _ resource.ResourceWithModifyPlan = &VariableResource{}
 
 func NewVariableResource() resource.Resource {
 	return &VariableResource{}
@@ -38,7 +39,7 @@ func (r *VariableResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_variable"
 }
 
-func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VariableResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Creates, updates and destroys variables.\nVariable can be either a Terraform variable or an Environment variable. For more information: [ControlMonkey Documentation](https://docs.controlmonkey.io/main-concepts/variables)",
 		Attributes: map[string]schema.Attribute{
@@ -127,6 +128,9 @@ func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -197,8 +201,31 @@ func (r *VariableResource) ValidateConfig(ctx context.Context, req resource.Vali
 	}
 }
 
+// ModifyPlan fails when the variable is planned in a namespace that is not allowed by the provider configuration.
+func (r *VariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.client.hasNamespaceRestrictions() {
+		return
+	}
+
+	var plan variable.ResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	switch plan.Scope.ValueString() {
+	case cmTypes.NamespaceScope:
+		resp.Diagnostics.Append(r.client.namespaceNotAllowedDiagnostics(ctx, path.Root("scope_id"), plan.ScopeId)...)
+	case cmTypes.StackScope:
+		resp.Diagnostics.Append(r.client.stackNamespaceNotAllowedDiagnostics(ctx, path.Root("scope_id"), plan.ScopeId)...)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.client.isConfigured() {
+		return
+	}
+
 	// Get current state
 	var state variable.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -207,6 +234,12 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := readTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	res, err := r.client.Client.variable.ReadVariable(ctx, &sdkVariable.ReadVariableInput{VariableId: controlmonkey.String(id)})
 	if err != nil {
@@ -215,7 +248,7 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 			return
 		}
 
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read variable %s", id), fmt.Sprintf("%s", err))
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, fmt.Sprintf("Failed to read variable %s", id), "", err)...)
 		return
 	}
 
@@ -231,6 +264,12 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *VariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//Retrieve values from plan
 	var plan variable.ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -239,14 +278,17 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := createTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, _ := variable.Converter(&plan, nil, commons.CreateConverter)
 
 	res, err := r.client.Client.variable.CreateVariable(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Variable creation failed",
-			fmt.Sprintf("failed to create variable, error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, "Variable creation failed", "failed to create variable", err)...)
 		return
 	}
 
@@ -261,6 +303,12 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *VariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan variable.ResourceModel
 	var state variable.ResourceModel
@@ -274,6 +322,12 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := updateTimeoutContext(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueStringPointer()
 
 	body, _ := variable.Converter(&plan, &state, commons.UpdateConverter)
@@ -285,10 +339,7 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			resourceUpdateFailedError,
-			fmt.Sprintf("failed to update variable %s, error: %s", *id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnosticsForPlan(ctx, req.Plan, resourceUpdateFailedError, fmt.Sprintf("failed to update variable %s", *id), err)...)
 		return
 	}
 
@@ -301,6 +352,12 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.client.notConfiguredDiagnostics()...)
+	resp.Diagnostics.Append(r.client.readOnlyDiagnostics("delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state variable.ResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -309,6 +366,12 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := deleteTimeoutContext(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueStringPointer()
 
 	_, err := r.client.Client.variable.DeleteVariable(ctx, &sdkVariable.DeleteVariableInput{
@@ -320,10 +383,7 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Variable deletion failed",
-			fmt.Sprintf("Failed to delete variable %s, error: %s", *id, err),
-		)
+		resp.Diagnostics.Append(commons.ApiErrorDiagnostics(ctx, "Variable deletion failed", fmt.Sprintf("Failed to delete variable %s", *id), err)...)
 		return
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_config"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider/commons/test_helpers"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -62,7 +63,7 @@ func TestAccVariableResourceNamespace(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "namespace" {
-  name = "variable test"
+  name = "`+test_helpers.NamePrefix+`variable test"
 }
 
 resource "%s" "%s" {
@@ -97,7 +98,7 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "namespace" {
-  name = "variable test"
+  name = "`+test_helpers.NamePrefix+`variable test"
 }
 
 resource "%s" "%s" {
@@ -130,7 +131,7 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "namespace" {
-  name = "variable test"
+  name = "`+test_helpers.NamePrefix+`variable test"
 }
 
 resource "%s" "%s" {
@@ -160,7 +161,7 @@ resource "%s" "%s" {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "namespace" {
-  name = "variable test"
+  name = "`+test_helpers.NamePrefix+`variable test"
 }
 
 resource "%s" "%s" {
@@ -207,7 +208,7 @@ resource "%s" "%s" {
 				},
 				Config: providerConfig + fmt.Sprintf(`
 resource "cm_namespace" "namespace" {
-  name = "variable test"
+  name = "`+test_helpers.NamePrefix+`variable test"
 }
 
 variable "lte_value" {
@@ -427,7 +428,7 @@ func testAccVariableBlueprintResourceSetup() string {
 
 	return providerConfig + fmt.Sprintf(`
 resource "cm_blueprint" "test_blueprint" {
-    name = "Variable Test Blueprint"
+    name = "`+test_helpers.NamePrefix+`Variable Test Blueprint"
     description = "Blueprint for testing variables"
 
     blueprint_vcs_info = {
@@ -0,0 +1,82 @@
+// Command cm-export writes the Terraform configuration of a ControlMonkey organization: a resource block for every
+// namespace, stack, variable, template, blueprint, control policy, team and notification, along with the import blocks
+// that bring them under management with "terraform plan" and "terraform apply".
+//
+// The token is read from CONTROL_MONKEY_TOKEN, or else from the shared credentials file like the provider does. The API
+// endpoint is read from CONTROL_MONKEY_ENDPOINT, or else from the shared credentials file.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/terraform-provider-cm/internal/export"
+	"github.com/control-monkey/terraform-provider-cm/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func main() {
+	var (
+		outputDir             string
+		profile               string
+		sharedCredentialsFile string
+		requestsPerSecond     float64
+		burst                 int
+	)
+
+	flag.StringVar(&outputDir, "out", ".", "directory to write the configuration to")
+	flag.StringVar(&profile, "profile", "", "profile of the shared credentials file to use")
+	flag.StringVar(&sharedCredentialsFile, "shared-credentials-file", "", "path of the shared credentials file")
+	flag.Float64Var(&requestsPerSecond, "requests-per-second", 10, "maximum number of requests per second sent to ControlMonkey")
+	flag.IntVar(&burst, "burst", 10, "maximum number of requests sent to ControlMonkey at once")
+	flag.Parse()
+
+	config := &provider.Config{
+		Token:                 os.Getenv(credentials.EnvCredentialsVarToken),
+		Endpoint:              os.Getenv(provider.EndpointEnvVar),
+		Profile:               profile,
+		SharedCredentialsFile: sharedCredentialsFile,
+		RequestsPerSecond:     requestsPerSecond,
+		Burst:                 burst,
+	}
+
+	sess, diags := config.Session()
+	exitOnError(diags)
+
+	files, diags := export.Configuration(context.Background(), export.NewServices(sess))
+	exitOnError(diags)
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(outputDir, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			log.Fatal(err.Error())
+		}
+		fmt.Println(path)
+	}
+}
+
+func exitOnError(diags diag.Diagnostics) {
+	for _, d := range diags {
+		fmt.Fprintf(os.Stderr, "%s: %s\n%s\n", d.Severity(), d.Summary(), d.Detail())
+	}
+
+	if diags.HasError() {
+		os.Exit(1)
+	}
+}
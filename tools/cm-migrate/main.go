@@ -0,0 +1,106 @@
+// Command cm-migrate converts Terraform Cloud workspaces or an Atlantis repo config to the Terraform configuration of
+// ControlMonkey stacks, their variables and dependencies.
+//
+// Terraform Cloud workspaces are read from responses of the Terraform Cloud API saved to files, e.g. of
+// GET /organizations/:org/workspaces, GET /workspaces/:id/vars and GET /workspaces/:id/run-triggers?filter[run-trigger][type]=inbound:
+//
+//	cm-migrate -tfc workspaces.json -tfc vars.json -tfc run-triggers.json -namespace-id ns-123 -vcs-provider-id vcs-123
+//
+// An Atlantis repo config is read from its atlantis.yaml:
+//
+//	cm-migrate -atlantis atlantis.yaml -repo-name org/repo -namespace-id ns-123 -vcs-provider-id vcs-123
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/control-monkey/terraform-provider-cm/internal/export"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	var (
+		tfcFiles     fileList
+		atlantisFile string
+		outputDir    string
+		options      export.MigrationOptions
+	)
+
+	flag.Var(&tfcFiles, "tfc", "file of a Terraform Cloud API response with workspaces, variables or run triggers, can be repeated")
+	flag.StringVar(&atlantisFile, "atlantis", "", "atlantis.yaml file to convert")
+	flag.StringVar(&outputDir, "out", ".", "directory to write the configuration to")
+	flag.StringVar(&options.NamespaceId, "namespace-id", "", "namespace of the stacks")
+	flag.StringVar(&options.VcsProviderId, "vcs-provider-id", "", "ControlMonkey VCS provider of the repositories")
+	flag.StringVar(&options.RepoName, "repo-name", "", "repository of the stacks, required for Atlantis and for workspaces that are not connected to a repository")
+	flag.Parse()
+
+	if (len(tfcFiles) == 0) == (atlantisFile == "") {
+		log.Fatal("exactly one of -tfc or -atlantis is required")
+	}
+
+	var files map[string][]byte
+	var diags diag.Diagnostics
+
+	if atlantisFile != "" {
+		config, err := os.ReadFile(atlantisFile)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		files, diags = export.MigrateAtlantisConfig(context.Background(), config, options)
+	} else {
+		var documents [][]byte
+		for _, f := range tfcFiles {
+			document, err := os.ReadFile(f)
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+			documents = append(documents, document)
+		}
+
+		files, diags = export.MigrateTfcWorkspaces(context.Background(), documents, options)
+	}
+
+	for _, d := range diags {
+		fmt.Fprintf(os.Stderr, "%s: %s\n%s\n", d.Severity(), d.Summary(), d.Detail())
+	}
+	if diags.HasError() {
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(outputDir, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			log.Fatal(err.Error())
+		}
+		fmt.Println(path)
+	}
+}